@@ -22,6 +22,10 @@ const (
 	TestConfigTeam
 	TestConfigUserID
 	TestConfigPipelineID
+	TestConfigFakeAPIKey
+	TestConfigAddonID
+	TestConfigEnterpriseAccountID
+	TestConfigTeamFeature
 )
 
 var testConfigKeyToEnvName = map[TestConfigKey]string{
@@ -35,6 +39,10 @@ var testConfigKeyToEnvName = map[TestConfigKey]string{
 	TestConfigTeam:                 "HEROKU_TEAM",
 	TestConfigUserID:               "HEROKU_USER_ID",
 	TestConfigPipelineID:           "HEROKU_PIPELINE_ID",
+	TestConfigFakeAPIKey:           "HEROKU_FAKE_API",
+	TestConfigAddonID:              "HEROKU_ADDON_ID",
+	TestConfigEnterpriseAccountID:  "HEROKU_ENTERPRISE_ACCOUNT_ID",
+	TestConfigTeamFeature:          "HEROKU_TEAM_FEATURE",
 	TestConfigAcceptanceTestKey:    resource.TestEnvVar,
 }
 
@@ -137,3 +145,15 @@ func (t *TestConfig) GetUserIDOrSkip(testing *testing.T) (val string) {
 func (t *TestConfig) GetPipelineIDorSkip(testing *testing.T) (val string) {
 	return t.GetOrSkip(testing, TestConfigPipelineID)
 }
+
+func (t *TestConfig) GetAddonIDOrSkip(testing *testing.T) (val string) {
+	return t.GetOrSkip(testing, TestConfigAddonID)
+}
+
+func (t *TestConfig) GetEnterpriseAccountIDOrSkip(testing *testing.T) (val string) {
+	return t.GetOrSkip(testing, TestConfigEnterpriseAccountID)
+}
+
+func (t *TestConfig) GetTeamFeatureOrSkip(testing *testing.T) (val string) {
+	return t.GetOrSkip(testing, TestConfigTeamFeature)
+}
@@ -0,0 +1,550 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// FakeAPIKey is the API key accepted by FakeAPI.
+	FakeAPIKey = "fake-api-key"
+
+	fakeBlobsPath = "/_fake/blobs/"
+)
+
+// FakeAPI is an in-memory, stateful stand-in for the Heroku Platform API, for
+// exercising the provider without a Heroku account.
+//
+// Requests are routed using the links of the Platform API JSON schema vendored
+// with heroku-go. Links of the following resources are implemented: account,
+// apps, config vars, add-ons, builds, slugs, sources, releases, formations,
+// domains, pipelines, pipeline couplings and spaces. Any other link in the
+// schema responds with 501 Not Implemented.
+type FakeAPI struct {
+	server   *httptest.Server
+	links    []schemaLink
+	handlers map[string]fakeHandler
+
+	mu         sync.Mutex
+	account    fakeObject
+	objects    map[string][]fakeObject
+	configVars map[string]map[string]string
+	buildpacks map[string][]string
+	blobs      map[string][]byte
+}
+
+// fakeObject is a Platform API resource, as rendered to JSON.
+type fakeObject map[string]interface{}
+
+// fakeRequest is a request matched to a schema link.
+type fakeRequest struct {
+	params []string
+	body   map[string]interface{}
+	header http.Header
+}
+
+type fakeHandler func(req *fakeRequest) (int, interface{})
+
+type schemaLink struct {
+	key      string
+	method   string
+	pattern  *regexp.Regexp
+	literals int
+}
+
+// NewFakeAPI starts a FakeAPI on a local port. Call Close when done.
+func NewFakeAPI() (*FakeAPI, error) {
+	links, err := loadSchemaLinks()
+	if err != nil {
+		return nil, err
+	}
+
+	f := &FakeAPI{
+		links:      links,
+		handlers:   make(map[string]fakeHandler),
+		objects:    make(map[string][]fakeObject),
+		configVars: make(map[string]map[string]string),
+		buildpacks: make(map[string][]string),
+		blobs:      make(map[string][]byte),
+	}
+
+	f.account = fakeObject{
+		"id":                        uuid.NewString(),
+		"email":                     "fake@example.com",
+		"name":                      "Fake User",
+		"allow_tracking":            true,
+		"beta":                      false,
+		"verified":                  true,
+		"two_factor_authentication": false,
+		"federated":                 false,
+		"default_team":              nil,
+		"default_organization":      nil,
+		"created_at":                fakeNow(),
+		"updated_at":                fakeNow(),
+	}
+
+	f.registerAccountHandlers()
+	f.registerAppHandlers()
+	f.registerAddonHandlers()
+	f.registerBuildHandlers()
+	f.registerPipelineHandlers()
+	f.registerSpaceHandlers()
+
+	f.server = httptest.NewServer(f)
+	return f, nil
+}
+
+// URL is the base URL of the FakeAPI, for the provider's `url` setting.
+func (f *FakeAPI) URL() string {
+	return f.server.URL
+}
+
+// Close shuts down the FakeAPI.
+func (f *FakeAPI) Close() {
+	f.server.Close()
+}
+
+var sharedFakeAPI struct {
+	once sync.Once
+	api  *FakeAPI
+	err  error
+}
+
+// ConfigureFakeAPI points the provider at a FakeAPI when HEROKU_FAKE_API is
+// set, by setting HEROKU_API_URL and HEROKU_API_KEY. The FakeAPI is shared by
+// every test in the test binary.
+func (t *TestConfig) ConfigureFakeAPI(testing *testing.T) {
+	if t.Get(TestConfigFakeAPIKey) == "" {
+		return
+	}
+
+	sharedFakeAPI.once.Do(func() {
+		sharedFakeAPI.api, sharedFakeAPI.err = NewFakeAPI()
+		if sharedFakeAPI.err != nil {
+			return
+		}
+		os.Setenv("HEROKU_API_URL", sharedFakeAPI.api.URL())
+		os.Setenv(TestConfigAPIKey.String(), FakeAPIKey)
+	})
+
+	if sharedFakeAPI.err != nil {
+		testing.Fatalf("stopping test: unable to start fake Heroku API: %s", sharedFakeAPI.err)
+	}
+}
+
+// loadSchemaLinks reads the links of the Platform API schema vendored with
+// heroku-go.
+func loadSchemaLinks() ([]schemaLink, error) {
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		return nil, fmt.Errorf("unable to locate the Platform API schema")
+	}
+	path := filepath.Join(filepath.Dir(file), "..", "..", "vendor", "github.com", "heroku", "heroku-go", "v6", "schema.json")
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Platform API schema: %w", err)
+	}
+
+	var schema struct {
+		Definitions map[string]struct {
+			Links []struct {
+				Href   string `json:"href"`
+				Method string `json:"method"`
+				Title  string `json:"title"`
+			} `json:"links"`
+		} `json:"definitions"`
+	}
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		return nil, fmt.Errorf("error parsing Platform API schema: %w", err)
+	}
+
+	param := regexp.MustCompile(`\{\([^}]*\)\}`)
+
+	var links []schemaLink
+	for name, definition := range schema.Definitions {
+		for _, link := range definition.Links {
+			parts := param.Split(link.Href, -1)
+			for i := range parts {
+				parts[i] = regexp.QuoteMeta(parts[i])
+			}
+			links = append(links, schemaLink{
+				key:      fakeHandlerKey(name, link.Title),
+				method:   link.Method,
+				pattern:  regexp.MustCompile("^" + strings.Join(parts, "([^/]+)") + "$"),
+				literals: len(param.ReplaceAllString(link.Href, "")),
+			})
+		}
+	}
+
+	// Prefer the link with the most literal text when several match a path.
+	sort.SliceStable(links, func(i, j int) bool {
+		if links[i].literals != links[j].literals {
+			return links[i].literals > links[j].literals
+		}
+		return links[i].key < links[j].key
+	})
+
+	return links, nil
+}
+
+func fakeHandlerKey(definition, title string) string {
+	return definition + " " + title
+}
+
+// handle registers the handler for the schema link with the given definition
+// and title.
+func (f *FakeAPI) handle(definition, title string, h fakeHandler) {
+	key := fakeHandlerKey(definition, title)
+	for _, link := range f.links {
+		if link.key == key {
+			f.handlers[key] = h
+			return
+		}
+	}
+	panic(fmt.Sprintf("fake Heroku API: no schema link %q", key))
+}
+
+func (f *FakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasPrefix(r.URL.Path, fakeBlobsPath) {
+		f.serveBlob(w, r)
+		return
+	}
+
+	if _, password, _ := r.BasicAuth(); password != FakeAPIKey && r.Header.Get("Authorization") != "Bearer "+FakeAPIKey {
+		writeFakeError(w, http.StatusUnauthorized, "unauthorized", "Invalid credentials provided.")
+		return
+	}
+
+	var matched *schemaLink
+	var matches []string
+	path := r.URL.EscapedPath()
+	for i, link := range f.links {
+		if link.method != r.Method {
+			continue
+		}
+		// Several links may share a method and href, such as release Create
+		// and Rollback, so fall through to any of them with a handler.
+		if matched != nil && link.pattern.String() != matched.pattern.String() {
+			continue
+		}
+		m := link.pattern.FindStringSubmatch(path)
+		if m == nil {
+			continue
+		}
+		if matched == nil {
+			matched, matches = &f.links[i], m
+		}
+		if _, ok := f.handlers[link.key]; ok {
+			matched = &f.links[i]
+			break
+		}
+	}
+
+	if matched != nil {
+		h, ok := f.handlers[matched.key]
+		if !ok {
+			writeFakeError(w, http.StatusNotImplemented, "not_implemented",
+				fmt.Sprintf("The fake Heroku API does not implement %s.", matched.key))
+			return
+		}
+
+		req := &fakeRequest{header: r.Header, body: map[string]interface{}{}}
+		for _, m := range matches[1:] {
+			p, _ := url.PathUnescape(m)
+			req.params = append(req.params, p)
+		}
+		if r.Body != nil {
+			raw, _ := io.ReadAll(r.Body)
+			if len(raw) > 0 {
+				if err := json.Unmarshal(raw, &req.body); err != nil {
+					writeFakeError(w, http.StatusBadRequest, "bad_request", "Invalid JSON in request body.")
+					return
+				}
+			}
+		}
+
+		f.serveHandler(w, r, h, req)
+		return
+	}
+
+	writeFakeError(w, http.StatusNotFound, "not_found", "The requested API endpoint was not found.")
+}
+
+// serveHandler runs a handler while holding the lock, which is released even
+// if the handler panics, so that later requests fail rather than hang.
+func (f *FakeAPI) serveHandler(w http.ResponseWriter, r *http.Request, h fakeHandler, req *fakeRequest) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	status, body := h(req)
+	if list, ok := body.([]fakeObject); ok {
+		body = applyRange(list, r.Header.Get("Range"))
+	}
+	writeFakeJSON(w, status, body)
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("RateLimit-Remaining", "4500")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// applyRange honors the order and max of a Range header on list responses.
+func applyRange(list []fakeObject, rangeHeader string) []fakeObject {
+	if rangeHeader == "" {
+		return list
+	}
+
+	if strings.Contains(rangeHeader, "order=desc") {
+		reversed := make([]fakeObject, len(list))
+		for i, o := range list {
+			reversed[len(list)-1-i] = o
+		}
+		list = reversed
+	}
+
+	if m := regexp.MustCompile(`max=(\d+)`).FindStringSubmatch(rangeHeader); m != nil {
+		if max, err := strconv.Atoi(m[1]); err == nil && max < len(list) {
+			list = list[:max]
+		}
+	}
+
+	return list
+}
+
+func writeFakeError(w http.ResponseWriter, status int, id, message string) {
+	writeFakeJSON(w, status, map[string]string{"id": id, "message": message})
+}
+
+func fakeError(status int, id, message string) (int, interface{}) {
+	return status, map[string]string{"id": id, "message": message}
+}
+
+func fakeNotFound(kind string) (int, interface{}) {
+	return fakeError(http.StatusNotFound, "not_found", fmt.Sprintf("Couldn't find that %s.", kind))
+}
+
+func fakeNow() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// serveBlob stores and serves the contents uploaded to source and slug blob
+// URLs.
+func (f *FakeAPI) serveBlob(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, fakeBlobsPath)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.blobs[id] = raw
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		raw, ok := f.blobs[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(raw)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *FakeAPI) blobURL(id string) string {
+	return f.server.URL + fakeBlobsPath + id
+}
+
+// insert stores a new object of the given kind.
+func (f *FakeAPI) insert(kind string, o fakeObject) fakeObject {
+	f.objects[kind] = append(f.objects[kind], o)
+	return o
+}
+
+// remove deletes an object of the given kind.
+func (f *FakeAPI) remove(kind string, o fakeObject) {
+	objects := f.objects[kind]
+	for i, existing := range objects {
+		if existing["id"] == o["id"] {
+			f.objects[kind] = append(objects[:i:i], objects[i+1:]...)
+			return
+		}
+	}
+}
+
+// filter returns the objects of the given kind which match.
+func (f *FakeAPI) filter(kind string, match func(fakeObject) bool) []fakeObject {
+	result := []fakeObject{}
+	for _, o := range f.objects[kind] {
+		if match == nil || match(o) {
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// lookup finds an object of the given kind by its id, or by any of the given
+// fields.
+func (f *FakeAPI) lookup(kind, identity string, fields ...string) fakeObject {
+	for _, o := range f.objects[kind] {
+		if o["id"] == identity {
+			return o
+		}
+		for _, field := range fields {
+			if o[field] == identity {
+				return o
+			}
+		}
+	}
+	return nil
+}
+
+// lookupChild finds an object of the given kind belonging to the parent
+// referenced by parentField.
+func (f *FakeAPI) lookupChild(kind, parentField, parentID, identity string, fields ...string) fakeObject {
+	for _, o := range f.objects[kind] {
+		if refID(o, parentField) != parentID {
+			continue
+		}
+		if o["id"] == identity {
+			return o
+		}
+		for _, field := range fields {
+			if o[field] == identity {
+				return o
+			}
+		}
+	}
+	return nil
+}
+
+// ref renders the reference to an object embedded in other objects.
+func ref(o fakeObject) map[string]interface{} {
+	if o == nil {
+		return nil
+	}
+	r := map[string]interface{}{"id": o["id"]}
+	if name, ok := o["name"]; ok {
+		r["name"] = name
+	}
+	return r
+}
+
+// refID returns the id of the object referenced by field.
+func refID(o fakeObject, field string) string {
+	if r, ok := o[field].(map[string]interface{}); ok {
+		id, _ := r["id"].(string)
+		return id
+	}
+	return ""
+}
+
+func bodyString(body map[string]interface{}, key string) (string, bool) {
+	v, ok := body[key].(string)
+	return v, ok
+}
+
+func bodyBool(body map[string]interface{}, key string) (bool, bool) {
+	v, ok := body[key].(bool)
+	return v, ok
+}
+
+func bodyMap(body map[string]interface{}, key string) map[string]interface{} {
+	v, _ := body[key].(map[string]interface{})
+	if v == nil {
+		return map[string]interface{}{}
+	}
+	return v
+}
+
+// bodyIdentity returns a field that is either an identity string, or an
+// object with an id or name.
+func bodyIdentity(body map[string]interface{}, key string) string {
+	switch v := body[key].(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		if id, ok := v["id"].(string); ok && id != "" {
+			return id
+		}
+		if name, ok := v["name"].(string); ok {
+			return name
+		}
+	}
+	return ""
+}
+
+func shortID() string {
+	return strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+}
+
+func (f *FakeAPI) registerAccountHandlers() {
+	f.handle("account", "Info", func(req *fakeRequest) (int, interface{}) {
+		return http.StatusOK, f.account
+	})
+
+	f.handle("rate-limit", "Info", func(req *fakeRequest) (int, interface{}) {
+		return http.StatusOK, map[string]int{"remaining": 4500}
+	})
+
+	f.handle("generation", "List", func(req *fakeRequest) (int, interface{}) {
+		return http.StatusOK, []fakeObject{fakeGeneration("cedar"), fakeGeneration("fir")}
+	})
+
+	f.handle("generation", "Info", func(req *fakeRequest) (int, interface{}) {
+		if req.params[0] != "cedar" && req.params[0] != "fir" {
+			return fakeNotFound("generation")
+		}
+		return http.StatusOK, fakeGeneration(req.params[0])
+	})
+}
+
+func fakeGeneration(name string) fakeObject {
+	return fakeObject{
+		"id":         uuid.NewSHA1(uuid.NameSpaceURL, []byte(name)).String(),
+		"name":       name,
+		"created_at": fakeNow(),
+		"updated_at": fakeNow(),
+	}
+}
+
+// team finds, or creates, the team with the given identity. Teams are
+// created on first reference, so any team name is valid.
+func (f *FakeAPI) team(identity string) fakeObject {
+	if t := f.lookup("team", identity, "name"); t != nil {
+		return t
+	}
+	return f.insert("team", fakeObject{
+		"id":         uuid.NewString(),
+		"name":       identity,
+		"role":       "admin",
+		"type":       "team",
+		"default":    false,
+		"created_at": fakeNow(),
+		"updated_at": fakeNow(),
+	})
+}
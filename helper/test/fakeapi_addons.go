@@ -0,0 +1,158 @@
+package test
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+func (f *FakeAPI) registerAddonHandlers() {
+	f.handle("add-on", "Create", f.addonCreate)
+	f.handle("add-on", "Info", f.addonInfo)
+	f.handle("add-on", "Info By App", f.addonInfoByApp)
+	f.handle("add-on", "List", f.addonList)
+	f.handle("add-on", "List By App", f.addonListByApp)
+	f.handle("add-on", "Update", f.addonUpdate)
+	f.handle("add-on", "Delete", f.addonDelete)
+}
+
+func (f *FakeAPI) addonCreate(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+
+	plan, _ := bodyString(req.body, "plan")
+	if plan == "" {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Plan can't be blank.")
+	}
+	if !strings.Contains(plan, ":") {
+		plan += ":test"
+	}
+	service := strings.SplitN(plan, ":", 2)[0]
+
+	name, _ := bodyString(req.body, "name")
+	if name == "" {
+		name = service + "-" + shortID()
+	}
+	if f.lookup("addon", name, "name") != nil {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Name "+name+" is already taken")
+	}
+
+	// Add-ons provision asynchronously, and are provisioned when next read.
+	addon := f.insert("addon", fakeObject{
+		"id":             uuid.NewString(),
+		"name":           name,
+		"app":            ref(app),
+		"addon_service":  map[string]interface{}{"id": uuid.NewSHA1(uuid.NameSpaceURL, []byte(service)).String(), "name": service},
+		"plan":           map[string]interface{}{"id": uuid.NewSHA1(uuid.NameSpaceURL, []byte(plan)).String(), "name": plan},
+		"billing_entity": map[string]interface{}{"id": app["id"], "name": app["name"], "type": "app"},
+		"billed_price":   nil,
+		"actions":        []interface{}{},
+		"provider_id":    shortID(),
+		"state":          "provisioning",
+		"web_url":        "https://addons-sso.heroku.com/apps/" + app["id"].(string),
+		"created_at":     fakeNow(),
+		"updated_at":     fakeNow(),
+	})
+
+	configVar := strings.ToUpper(strings.ReplaceAll(service, "-", "_")) + "_URL"
+	if as, ok := bodyString(bodyMap(req.body, "attachment"), "name"); ok && as != "" {
+		configVar = strings.ToUpper(as) + "_URL"
+	}
+	addon["config_vars"] = []string{configVar}
+
+	if config := bodyMap(req.body, "config"); len(config) > 0 {
+		addon["config"] = config
+	}
+
+	f.setConfigVars(app, map[string]interface{}{configVar: "https://" + service + ".example.com/" + addon["provider_id"].(string)})
+	f.addRelease(app, "Attach "+configVar+" resource", nil)
+
+	return http.StatusCreated, addon
+}
+
+// provisioned completes the provisioning of an add-on.
+func provisioned(addon fakeObject) fakeObject {
+	if addon["state"] == "provisioning" {
+		addon["state"] = "provisioned"
+	}
+	return addon
+}
+
+func (f *FakeAPI) addonInfo(req *fakeRequest) (int, interface{}) {
+	addon := f.lookup("addon", req.params[0], "name")
+	if addon == nil {
+		return fakeNotFound("add-on")
+	}
+	return http.StatusOK, provisioned(addon)
+}
+
+func (f *FakeAPI) addonInfoByApp(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	addon := f.lookupChild("addon", "app", app["id"].(string), req.params[1], "name")
+	if addon == nil {
+		return fakeNotFound("add-on")
+	}
+	return http.StatusOK, provisioned(addon)
+}
+
+func (f *FakeAPI) addonList(req *fakeRequest) (int, interface{}) {
+	return http.StatusOK, f.filter("addon", nil)
+}
+
+func (f *FakeAPI) addonListByApp(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	return http.StatusOK, f.filter("addon", func(o fakeObject) bool { return refID(o, "app") == app["id"] })
+}
+
+func (f *FakeAPI) addonUpdate(req *fakeRequest) (int, interface{}) {
+	status, body := f.addonInfoByApp(req)
+	if status != http.StatusOK {
+		return status, body
+	}
+
+	addon := body.(fakeObject)
+	if plan, ok := bodyString(req.body, "plan"); ok && plan != "" {
+		if !strings.Contains(plan, ":") {
+			plan = addon["addon_service"].(map[string]interface{})["name"].(string) + ":" + plan
+		}
+		addon["plan"] = map[string]interface{}{"id": uuid.NewSHA1(uuid.NameSpaceURL, []byte(plan)).String(), "name": plan}
+	}
+	if name, ok := bodyString(req.body, "name"); ok && name != "" {
+		addon["name"] = name
+	}
+	addon["updated_at"] = fakeNow()
+
+	return http.StatusOK, addon
+}
+
+func (f *FakeAPI) addonDelete(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	addon := f.lookupChild("addon", "app", app["id"].(string), req.params[1], "name")
+	if addon == nil {
+		return fakeNotFound("add-on")
+	}
+
+	unset := map[string]interface{}{}
+	for _, configVar := range addon["config_vars"].([]string) {
+		unset[configVar] = nil
+	}
+	f.setConfigVars(app, unset)
+	f.addRelease(app, "Detach "+addon["name"].(string), nil)
+
+	f.remove("addon", addon)
+	addon["state"] = "deprovisioned"
+
+	return http.StatusOK, addon
+}
@@ -0,0 +1,570 @@
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+func (f *FakeAPI) registerAppHandlers() {
+	f.handle("app", "Create", f.appCreate)
+	f.handle("app", "Info", f.appInfo)
+	f.handle("app", "List", f.appList)
+	f.handle("app", "Update", f.appUpdate)
+	f.handle("app", "Delete", f.appDelete)
+	f.handle("app", "Enable ACM", f.appSetACM(true))
+	f.handle("app", "Disable ACM", f.appSetACM(false))
+
+	f.handle("team-app", "Create", f.appCreate)
+	f.handle("team-app", "Info", f.appInfo)
+	f.handle("team-app", "Update Locked", f.teamAppUpdateLocked)
+
+	f.handle("config-var", "Info for App", f.configVarInfo)
+	f.handle("config-var", "Update", f.configVarUpdate)
+
+	f.handle("release", "Create", f.releaseCreate)
+	f.handle("release", "Info", f.releaseInfo)
+	f.handle("release", "List", f.releaseList)
+
+	f.handle("buildpack-installation", "List", f.buildpackInstallationList)
+	f.handle("buildpack-installation", "Update", f.buildpackInstallationUpdate)
+
+	f.handle("formation", "Info", f.formationInfo)
+	f.handle("formation", "List", f.formationList)
+	f.handle("formation", "Update", f.formationUpdate)
+	f.handle("formation", "Batch Update", f.formationBatchUpdate)
+
+	f.handle("domain", "Create", f.domainCreate)
+	f.handle("domain", "Info", f.domainInfo)
+	f.handle("domain", "List", f.domainList)
+	f.handle("domain", "Update", f.domainUpdate)
+	f.handle("domain", "Delete", f.domainDelete)
+}
+
+func (f *FakeAPI) app(identity string) fakeObject {
+	return f.lookup("app", identity, "name")
+}
+
+func (f *FakeAPI) appCreate(req *fakeRequest) (int, interface{}) {
+	name, _ := bodyString(req.body, "name")
+	if name == "" {
+		name = "fake-app-" + shortID()
+	}
+	if f.app(name) != nil {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Name "+name+" is already taken")
+	}
+
+	region, _ := bodyString(req.body, "region")
+	if region == "" {
+		region = "us"
+	}
+	stack, _ := bodyString(req.body, "stack")
+	if stack == "" {
+		stack = "heroku-24"
+	}
+
+	app := fakeObject{
+		"id":                             uuid.NewString(),
+		"name":                           name,
+		"region":                         map[string]interface{}{"id": uuid.NewString(), "name": region},
+		"build_stack":                    map[string]interface{}{"id": uuid.NewString(), "name": stack},
+		"stack":                          map[string]interface{}{"id": uuid.NewString(), "name": stack},
+		"generation":                     ref(fakeGeneration("cedar")),
+		"git_url":                        "https://git.heroku.com/" + name + ".git",
+		"web_url":                        "https://" + name + "-" + shortID() + ".herokuapp.com/",
+		"acm":                            false,
+		"internal_routing":               nil,
+		"maintenance":                    false,
+		"locked":                         false,
+		"owner":                          map[string]interface{}{"id": f.account["id"], "email": f.account["email"]},
+		"organization":                   nil,
+		"team":                           nil,
+		"space":                          nil,
+		"buildpack_provided_description": nil,
+		"created_at":                     fakeNow(),
+		"updated_at":                     fakeNow(),
+		"released_at":                    fakeNow(),
+	}
+
+	if identity := firstNonEmpty(bodyIdentity(req.body, "team"), bodyIdentity(req.body, "organization")); identity != "" {
+		team := f.team(identity)
+		app["team"] = ref(team)
+		app["organization"] = ref(team)
+		app["owner"] = map[string]interface{}{"id": team["id"], "email": fmt.Sprintf("%s@herokumanager.com", team["name"])}
+	}
+
+	if identity, ok := bodyString(req.body, "space"); ok && identity != "" {
+		space := f.lookup("space", identity, "name")
+		if space == nil {
+			return fakeNotFound("space")
+		}
+		app["space"] = map[string]interface{}{"id": space["id"], "name": space["name"], "shield": space["shield"]}
+		app["region"] = space["region"]
+		app["generation"] = space["generation"]
+		if internalRouting, ok := bodyBool(req.body, "internal_routing"); ok {
+			app["internal_routing"] = internalRouting
+		}
+	}
+
+	if locked, ok := bodyBool(req.body, "locked"); ok {
+		app["locked"] = locked
+	}
+
+	f.insert("app", app)
+	f.configVars[app["id"].(string)] = map[string]string{}
+	f.addRelease(app, "Initial release", nil)
+
+	return http.StatusCreated, app
+}
+
+func (f *FakeAPI) appInfo(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	return http.StatusOK, app
+}
+
+func (f *FakeAPI) appList(req *fakeRequest) (int, interface{}) {
+	return http.StatusOK, f.filter("app", nil)
+}
+
+func (f *FakeAPI) appUpdate(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+
+	if name, ok := bodyString(req.body, "name"); ok && name != app["name"] {
+		if f.app(name) != nil {
+			return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Name "+name+" is already taken")
+		}
+		app["name"] = name
+		app["git_url"] = "https://git.heroku.com/" + name + ".git"
+		f.renameReferences(app)
+	}
+	if stack, ok := bodyString(req.body, "build_stack"); ok {
+		app["build_stack"] = map[string]interface{}{"id": uuid.NewString(), "name": stack}
+	}
+	if maintenance, ok := bodyBool(req.body, "maintenance"); ok {
+		app["maintenance"] = maintenance
+	}
+	app["updated_at"] = fakeNow()
+
+	return http.StatusOK, app
+}
+
+// renameReferences updates the app name embedded in the app's children.
+func (f *FakeAPI) renameReferences(app fakeObject) {
+	for _, objects := range f.objects {
+		for _, o := range objects {
+			if refID(o, "app") == app["id"] {
+				o["app"] = ref(app)
+			}
+		}
+	}
+}
+
+func (f *FakeAPI) appDelete(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+
+	for kind := range f.objects {
+		for _, o := range f.filter(kind, func(o fakeObject) bool { return refID(o, "app") == app["id"] }) {
+			f.remove(kind, o)
+		}
+	}
+	delete(f.configVars, app["id"].(string))
+	delete(f.buildpacks, app["id"].(string))
+	f.remove("app", app)
+
+	return http.StatusOK, app
+}
+
+func (f *FakeAPI) appSetACM(enabled bool) fakeHandler {
+	return func(req *fakeRequest) (int, interface{}) {
+		app := f.app(req.params[0])
+		if app == nil {
+			return fakeNotFound("app")
+		}
+		app["acm"] = enabled
+		return http.StatusOK, app
+	}
+}
+
+func (f *FakeAPI) teamAppUpdateLocked(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	if app["team"] == nil {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Only team apps can be locked.")
+	}
+	if locked, ok := bodyBool(req.body, "locked"); ok {
+		app["locked"] = locked
+	}
+	return http.StatusOK, app
+}
+
+func (f *FakeAPI) configVarInfo(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	return http.StatusOK, f.configVars[app["id"].(string)]
+}
+
+func (f *FakeAPI) configVarUpdate(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+
+	changed := f.setConfigVars(app, req.body)
+	if len(changed) > 0 {
+		f.addRelease(app, "Set "+strings.Join(changed, ", ")+" config vars", nil)
+	}
+
+	return http.StatusOK, f.configVars[app["id"].(string)]
+}
+
+// setConfigVars applies config var updates, where a null value unsets the
+// var, returning the names of the vars which changed.
+func (f *FakeAPI) setConfigVars(app fakeObject, updates map[string]interface{}) []string {
+	vars := f.configVars[app["id"].(string)]
+
+	var changed []string
+	for k, v := range updates {
+		if s, ok := v.(string); ok {
+			if current, exists := vars[k]; !exists || current != s {
+				vars[k] = s
+				changed = append(changed, k)
+			}
+		} else if _, exists := vars[k]; exists {
+			delete(vars, k)
+			changed = append(changed, k)
+		}
+	}
+	sort.Strings(changed)
+
+	return changed
+}
+
+// addRelease creates a new, current release of the app, deploying the slug
+// if one is given.
+func (f *FakeAPI) addRelease(app fakeObject, description string, slug fakeObject) fakeObject {
+	releases := f.filter("release", func(o fakeObject) bool { return refID(o, "app") == app["id"] })
+	for _, r := range releases {
+		r["current"] = false
+	}
+
+	var slugRef interface{}
+	if slug == nil && len(releases) > 0 {
+		slugRef = releases[len(releases)-1]["slug"]
+	}
+	if slug != nil {
+		slugRef = map[string]interface{}{"id": slug["id"]}
+		f.scaleProcessTypes(app, slug)
+	}
+
+	release := f.insert("release", fakeObject{
+		"id":                uuid.NewString(),
+		"app":               ref(app),
+		"version":           len(releases) + 1,
+		"description":       description,
+		"status":            "succeeded",
+		"current":           true,
+		"slug":              slugRef,
+		"addon_plan_names":  []string{},
+		"artifacts":         []interface{}{},
+		"output_stream_url": nil,
+		"user":              map[string]interface{}{"id": f.account["id"], "email": f.account["email"]},
+		"created_at":        fakeNow(),
+		"updated_at":        fakeNow(),
+	})
+	app["released_at"] = fakeNow()
+
+	return release
+}
+
+func (f *FakeAPI) releaseCreate(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+
+	description, _ := bodyString(req.body, "description")
+
+	// Rollbacks share this link, identified by the release to roll back to.
+	if identity, ok := bodyString(req.body, "release"); ok {
+		target := f.lookupChild("release", "app", app["id"].(string), identity, "version")
+		if target == nil {
+			return fakeNotFound("release")
+		}
+		var slug fakeObject
+		if id := refID(target, "slug"); id != "" {
+			slug = f.lookup("slug", id)
+		}
+		return http.StatusCreated, f.addRelease(app, fmt.Sprintf("Rollback to v%v", target["version"]), slug)
+	}
+
+	slugID, _ := bodyString(req.body, "slug")
+	slug := f.lookup("slug", slugID)
+	if slug == nil {
+		return fakeNotFound("slug")
+	}
+	if description == "" {
+		description = "Deploy " + slugID
+	}
+
+	return http.StatusCreated, f.addRelease(app, description, slug)
+}
+
+func (f *FakeAPI) releaseInfo(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+
+	for _, r := range f.filter("release", func(o fakeObject) bool { return refID(o, "app") == app["id"] }) {
+		if r["id"] == req.params[1] || fmt.Sprint(r["version"]) == req.params[1] {
+			return http.StatusOK, r
+		}
+	}
+	return fakeNotFound("release")
+}
+
+func (f *FakeAPI) releaseList(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	return http.StatusOK, f.filter("release", func(o fakeObject) bool { return refID(o, "app") == app["id"] })
+}
+
+func (f *FakeAPI) buildpackInstallationList(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+
+	installations := []fakeObject{}
+	for i, buildpack := range f.buildpacks[app["id"].(string)] {
+		installations = append(installations, fakeObject{
+			"ordinal":   i,
+			"buildpack": map[string]interface{}{"url": buildpack, "name": buildpack},
+		})
+	}
+	return http.StatusOK, installations
+}
+
+func (f *FakeAPI) buildpackInstallationUpdate(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+
+	var buildpacks []string
+	updates, _ := req.body["updates"].([]interface{})
+	for _, u := range updates {
+		if update, ok := u.(map[string]interface{}); ok {
+			if buildpack, ok := bodyString(update, "buildpack"); ok {
+				buildpacks = append(buildpacks, buildpack)
+			}
+		}
+	}
+	f.buildpacks[app["id"].(string)] = buildpacks
+
+	return f.buildpackInstallationList(req)
+}
+
+// scaleProcessTypes creates formations for the process types of a deployed
+// slug, with a single web dyno.
+func (f *FakeAPI) scaleProcessTypes(app fakeObject, slug fakeObject) {
+	processTypes, _ := slug["process_types"].(map[string]interface{})
+	for processType, command := range processTypes {
+		if formation := f.lookupChild("formation", "app", app["id"].(string), processType, "type"); formation != nil {
+			formation["command"] = command
+			continue
+		}
+
+		quantity := 0
+		if processType == "web" {
+			quantity = 1
+		}
+		f.insert("formation", fakeObject{
+			"id":         uuid.NewString(),
+			"app":        ref(app),
+			"type":       processType,
+			"command":    command,
+			"quantity":   quantity,
+			"size":       "Basic",
+			"dyno_size":  map[string]interface{}{"id": uuid.NewString(), "name": "Basic"},
+			"created_at": fakeNow(),
+			"updated_at": fakeNow(),
+		})
+	}
+}
+
+func (f *FakeAPI) formationInfo(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	formation := f.lookupChild("formation", "app", app["id"].(string), req.params[1], "type")
+	if formation == nil {
+		return fakeNotFound("formation")
+	}
+	return http.StatusOK, formation
+}
+
+func (f *FakeAPI) formationList(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	return http.StatusOK, f.filter("formation", func(o fakeObject) bool { return refID(o, "app") == app["id"] })
+}
+
+// updateFormation applies quantity and size changes to a formation.
+func updateFormation(formation fakeObject, update map[string]interface{}) {
+	if quantity, ok := update["quantity"].(float64); ok {
+		formation["quantity"] = int(quantity)
+	}
+	if size, ok := bodyString(update, "size"); ok && size != "" {
+		formation["size"] = size
+		formation["dyno_size"] = map[string]interface{}{"id": uuid.NewString(), "name": size}
+	}
+	formation["updated_at"] = fakeNow()
+}
+
+func (f *FakeAPI) formationUpdate(req *fakeRequest) (int, interface{}) {
+	status, body := f.formationInfo(req)
+	if status != http.StatusOK {
+		return status, body
+	}
+
+	formation := body.(fakeObject)
+	updateFormation(formation, req.body)
+	return http.StatusOK, formation
+}
+
+func (f *FakeAPI) formationBatchUpdate(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+
+	updates, _ := req.body["updates"].([]interface{})
+
+	// Validate every update before applying any, as the batch is atomic.
+	var formations []fakeObject
+	for _, u := range updates {
+		update, _ := u.(map[string]interface{})
+		processType, _ := bodyString(update, "type")
+		formation := f.lookupChild("formation", "app", app["id"].(string), processType, "type")
+		if formation == nil {
+			return fakeNotFound("formation")
+		}
+		formations = append(formations, formation)
+	}
+
+	for i, u := range updates {
+		updateFormation(formations[i], u.(map[string]interface{}))
+	}
+
+	return http.StatusOK, formations
+}
+
+func (f *FakeAPI) domainCreate(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+
+	hostname, _ := bodyString(req.body, "hostname")
+	if hostname == "" {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Hostname can't be blank.")
+	}
+	if len(f.filter("domain", func(o fakeObject) bool { return o["hostname"] == hostname })) > 0 {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", hostname+" is currently in use by another app.")
+	}
+
+	var sniEndpoint interface{}
+	if identity, ok := bodyString(req.body, "sni_endpoint"); ok && identity != "" {
+		sniEndpoint = map[string]interface{}{"id": identity, "name": identity}
+	}
+
+	return http.StatusCreated, f.insert("domain", fakeObject{
+		"id":           uuid.NewString(),
+		"app":          ref(app),
+		"hostname":     hostname,
+		"cname":        shortID() + ".herokudns.com",
+		"kind":         "custom",
+		"status":       "succeeded",
+		"sni_endpoint": sniEndpoint,
+		"acm_status":   nil,
+		"created_at":   fakeNow(),
+		"updated_at":   fakeNow(),
+	})
+}
+
+func (f *FakeAPI) domainInfo(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	domain := f.lookupChild("domain", "app", app["id"].(string), req.params[1], "hostname")
+	if domain == nil {
+		return fakeNotFound("domain")
+	}
+	return http.StatusOK, domain
+}
+
+func (f *FakeAPI) domainList(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	return http.StatusOK, f.filter("domain", func(o fakeObject) bool { return refID(o, "app") == app["id"] })
+}
+
+func (f *FakeAPI) domainUpdate(req *fakeRequest) (int, interface{}) {
+	status, body := f.domainInfo(req)
+	if status != http.StatusOK {
+		return status, body
+	}
+
+	domain := body.(fakeObject)
+	if identity, ok := bodyString(req.body, "sni_endpoint"); ok && identity != "" {
+		domain["sni_endpoint"] = map[string]interface{}{"id": identity, "name": identity}
+	} else {
+		domain["sni_endpoint"] = nil
+	}
+	domain["updated_at"] = fakeNow()
+
+	return http.StatusOK, domain
+}
+
+func (f *FakeAPI) domainDelete(req *fakeRequest) (int, interface{}) {
+	status, body := f.domainInfo(req)
+	if status != http.StatusOK {
+		return status, body
+	}
+	f.remove("domain", body.(fakeObject))
+	return http.StatusOK, body
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,171 @@
+package test
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+func (f *FakeAPI) registerBuildHandlers() {
+	f.handle("source", "Create", f.sourceCreate)
+
+	f.handle("build", "Create", f.buildCreate)
+	f.handle("build", "Info", f.buildInfo)
+	f.handle("build", "List", f.buildList)
+
+	f.handle("slug", "Create", f.slugCreate)
+	f.handle("slug", "Info", f.slugInfo)
+}
+
+func (f *FakeAPI) sourceCreate(req *fakeRequest) (int, interface{}) {
+	blobURL := f.blobURL(uuid.NewString())
+	return http.StatusCreated, fakeObject{
+		"source_blob": map[string]interface{}{
+			"get_url": blobURL,
+			"put_url": blobURL,
+		},
+	}
+}
+
+func (f *FakeAPI) buildCreate(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+
+	sourceBlob := bodyMap(req.body, "source_blob")
+	if url, _ := bodyString(sourceBlob, "url"); url == "" {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Source blob URL can't be blank.")
+	}
+
+	buildpacks := []interface{}{}
+	if requested, ok := req.body["buildpacks"].([]interface{}); ok {
+		for _, b := range requested {
+			if buildpack, ok := b.(map[string]interface{}); ok {
+				url, _ := bodyString(buildpack, "url")
+				buildpacks = append(buildpacks, map[string]interface{}{"url": url, "name": url})
+			}
+		}
+	}
+
+	id := uuid.NewString()
+
+	// Builds run asynchronously, and succeed when next read.
+	return http.StatusCreated, f.insert("build", fakeObject{
+		"id":                id,
+		"app":               ref(app),
+		"buildpacks":        buildpacks,
+		"output_stream_url": f.blobURL(id),
+		"release":           nil,
+		"slug":              nil,
+		"source_blob": map[string]interface{}{
+			"url":                 sourceBlob["url"],
+			"checksum":            sourceBlob["checksum"],
+			"version":             sourceBlob["version"],
+			"version_description": sourceBlob["version_description"],
+		},
+		"stack":      app["build_stack"].(map[string]interface{})["name"],
+		"status":     "pending",
+		"user":       map[string]interface{}{"id": f.account["id"], "email": f.account["email"]},
+		"created_at": fakeNow(),
+		"updated_at": fakeNow(),
+	})
+}
+
+// completeBuild finishes a pending build, releasing a slug with a web
+// process.
+func (f *FakeAPI) completeBuild(build fakeObject) fakeObject {
+	if build["status"] != "pending" {
+		return build
+	}
+
+	app := f.app(refID(build, "app"))
+	slug := f.newSlug(app, map[string]interface{}{"web": "./bin/web"})
+	release := f.addRelease(app, "Deploy "+build["id"].(string)[:8], slug)
+
+	f.blobs[build["id"].(string)] = []byte("-----> Build succeeded!\n")
+
+	build["slug"] = map[string]interface{}{"id": slug["id"]}
+	build["release"] = map[string]interface{}{"id": release["id"]}
+	build["status"] = "succeeded"
+	build["updated_at"] = fakeNow()
+
+	return build
+}
+
+func (f *FakeAPI) buildInfo(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	build := f.lookupChild("build", "app", app["id"].(string), req.params[1])
+	if build == nil {
+		return fakeNotFound("build")
+	}
+	return http.StatusOK, f.completeBuild(build)
+}
+
+func (f *FakeAPI) buildList(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	return http.StatusOK, f.filter("build", func(o fakeObject) bool { return refID(o, "app") == app["id"] })
+}
+
+func (f *FakeAPI) newSlug(app fakeObject, processTypes map[string]interface{}) fakeObject {
+	id := uuid.NewString()
+	return f.insert("slug", fakeObject{
+		"id":                             id,
+		"app":                            ref(app),
+		"blob":                           map[string]interface{}{"method": "PUT", "url": f.blobURL(id)},
+		"buildpack_provided_description": nil,
+		"checksum":                       nil,
+		"commit":                         nil,
+		"commit_description":             nil,
+		"process_types":                  processTypes,
+		"size":                           nil,
+		"stack":                          app["build_stack"],
+		"created_at":                     fakeNow(),
+		"updated_at":                     fakeNow(),
+	})
+}
+
+func (f *FakeAPI) slugCreate(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+
+	processTypes, ok := req.body["process_types"].(map[string]interface{})
+	if !ok {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Process types can't be blank.")
+	}
+
+	slug := f.newSlug(app, processTypes)
+	for _, field := range []string{"buildpack_provided_description", "checksum", "commit", "commit_description"} {
+		if v, ok := bodyString(req.body, field); ok {
+			slug[field] = v
+		}
+	}
+	if stack, ok := bodyString(req.body, "stack"); ok && stack != "" {
+		slug["stack"] = map[string]interface{}{"id": uuid.NewString(), "name": stack}
+	}
+
+	return http.StatusCreated, slug
+}
+
+func (f *FakeAPI) slugInfo(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	slug := f.lookupChild("slug", "app", app["id"].(string), req.params[1])
+	if slug == nil {
+		return fakeNotFound("slug")
+	}
+	if raw, ok := f.blobs[slug["id"].(string)]; ok {
+		slug["size"] = len(raw)
+	}
+	return http.StatusOK, slug
+}
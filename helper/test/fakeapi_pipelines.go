@@ -0,0 +1,168 @@
+package test
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+func (f *FakeAPI) registerPipelineHandlers() {
+	f.handle("pipeline", "Create", f.pipelineCreate)
+	f.handle("pipeline", "Info", f.pipelineInfo)
+	f.handle("pipeline", "List", f.pipelineList)
+	f.handle("pipeline", "Update", f.pipelineUpdate)
+	f.handle("pipeline", "Delete", f.pipelineDelete)
+
+	f.handle("pipeline-coupling", "Create", f.pipelineCouplingCreate)
+	f.handle("pipeline-coupling", "Info", f.pipelineCouplingInfo)
+	f.handle("pipeline-coupling", "Info By App", f.pipelineCouplingInfoByApp)
+	f.handle("pipeline-coupling", "List", f.pipelineCouplingList)
+	f.handle("pipeline-coupling", "List By Pipeline", f.pipelineCouplingListByPipeline)
+	f.handle("pipeline-coupling", "Update", f.pipelineCouplingUpdate)
+	f.handle("pipeline-coupling", "Delete", f.pipelineCouplingDelete)
+}
+
+func (f *FakeAPI) pipelineCreate(req *fakeRequest) (int, interface{}) {
+	name, _ := bodyString(req.body, "name")
+	if name == "" {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Name can't be blank.")
+	}
+
+	owner := bodyMap(req.body, "owner")
+	if len(owner) == 0 {
+		owner = map[string]interface{}{"id": f.account["id"], "type": "user"}
+	}
+
+	return http.StatusCreated, f.insert("pipeline", fakeObject{
+		"id":         uuid.NewString(),
+		"name":       name,
+		"owner":      owner,
+		"generation": ref(fakeGeneration("cedar")),
+		"created_at": fakeNow(),
+		"updated_at": fakeNow(),
+	})
+}
+
+func (f *FakeAPI) pipelineInfo(req *fakeRequest) (int, interface{}) {
+	pipeline := f.lookup("pipeline", req.params[0], "name")
+	if pipeline == nil {
+		return fakeNotFound("pipeline")
+	}
+	return http.StatusOK, pipeline
+}
+
+func (f *FakeAPI) pipelineList(req *fakeRequest) (int, interface{}) {
+	return http.StatusOK, f.filter("pipeline", nil)
+}
+
+func (f *FakeAPI) pipelineUpdate(req *fakeRequest) (int, interface{}) {
+	pipeline := f.lookup("pipeline", req.params[0])
+	if pipeline == nil {
+		return fakeNotFound("pipeline")
+	}
+	if name, ok := bodyString(req.body, "name"); ok && name != "" {
+		pipeline["name"] = name
+	}
+	pipeline["updated_at"] = fakeNow()
+	return http.StatusOK, pipeline
+}
+
+func (f *FakeAPI) pipelineDelete(req *fakeRequest) (int, interface{}) {
+	pipeline := f.lookup("pipeline", req.params[0])
+	if pipeline == nil {
+		return fakeNotFound("pipeline")
+	}
+	for _, coupling := range f.filter("pipeline-coupling", func(o fakeObject) bool { return refID(o, "pipeline") == pipeline["id"] }) {
+		f.remove("pipeline-coupling", coupling)
+	}
+	f.remove("pipeline", pipeline)
+	return http.StatusOK, pipeline
+}
+
+func (f *FakeAPI) pipelineCouplingCreate(req *fakeRequest) (int, interface{}) {
+	app := f.app(bodyIdentity(req.body, "app"))
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	pipeline := f.lookup("pipeline", bodyIdentity(req.body, "pipeline"), "name")
+	if pipeline == nil {
+		return fakeNotFound("pipeline")
+	}
+	stage, _ := bodyString(req.body, "stage")
+	if stage == "" {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Stage can't be blank.")
+	}
+	if len(f.filter("pipeline-coupling", func(o fakeObject) bool { return refID(o, "app") == app["id"] })) > 0 {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "App is already coupled to a pipeline.")
+	}
+
+	// A pipeline adopts the generation of its first app.
+	couplings := f.filter("pipeline-coupling", func(o fakeObject) bool { return refID(o, "pipeline") == pipeline["id"] })
+	if len(couplings) == 0 {
+		pipeline["generation"] = app["generation"]
+	} else if refID(pipeline, "generation") != refID(app, "generation") {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "App generation does not match the pipeline's generation.")
+	}
+
+	return http.StatusCreated, f.insert("pipeline-coupling", fakeObject{
+		"id":         uuid.NewString(),
+		"app":        ref(app),
+		"pipeline":   ref(pipeline),
+		"stage":      stage,
+		"created_at": fakeNow(),
+		"updated_at": fakeNow(),
+	})
+}
+
+func (f *FakeAPI) pipelineCouplingInfo(req *fakeRequest) (int, interface{}) {
+	coupling := f.lookup("pipeline-coupling", req.params[0])
+	if coupling == nil {
+		return fakeNotFound("pipeline coupling")
+	}
+	return http.StatusOK, coupling
+}
+
+func (f *FakeAPI) pipelineCouplingInfoByApp(req *fakeRequest) (int, interface{}) {
+	app := f.app(req.params[0])
+	if app == nil {
+		return fakeNotFound("app")
+	}
+	couplings := f.filter("pipeline-coupling", func(o fakeObject) bool { return refID(o, "app") == app["id"] })
+	if len(couplings) == 0 {
+		return fakeNotFound("pipeline coupling")
+	}
+	return http.StatusOK, couplings[0]
+}
+
+func (f *FakeAPI) pipelineCouplingList(req *fakeRequest) (int, interface{}) {
+	return http.StatusOK, f.filter("pipeline-coupling", nil)
+}
+
+func (f *FakeAPI) pipelineCouplingListByPipeline(req *fakeRequest) (int, interface{}) {
+	pipeline := f.lookup("pipeline", req.params[0])
+	if pipeline == nil {
+		return fakeNotFound("pipeline")
+	}
+	return http.StatusOK, f.filter("pipeline-coupling", func(o fakeObject) bool { return refID(o, "pipeline") == pipeline["id"] })
+}
+
+func (f *FakeAPI) pipelineCouplingUpdate(req *fakeRequest) (int, interface{}) {
+	coupling := f.lookup("pipeline-coupling", req.params[0])
+	if coupling == nil {
+		return fakeNotFound("pipeline coupling")
+	}
+	if stage, ok := bodyString(req.body, "stage"); ok && stage != "" {
+		coupling["stage"] = stage
+	}
+	coupling["updated_at"] = fakeNow()
+	return http.StatusOK, coupling
+}
+
+func (f *FakeAPI) pipelineCouplingDelete(req *fakeRequest) (int, interface{}) {
+	coupling := f.lookup("pipeline-coupling", req.params[0])
+	if coupling == nil {
+		return fakeNotFound("pipeline coupling")
+	}
+	f.remove("pipeline-coupling", coupling)
+	return http.StatusOK, coupling
+}
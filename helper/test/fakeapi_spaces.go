@@ -0,0 +1,125 @@
+package test
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+func (f *FakeAPI) registerSpaceHandlers() {
+	f.handle("space", "Create", f.spaceCreate)
+	f.handle("space", "Info", f.spaceInfo)
+	f.handle("space", "List", f.spaceList)
+	f.handle("space", "Update", f.spaceUpdate)
+	f.handle("space", "Delete", f.spaceDelete)
+
+	f.handle("space-nat", "Info", f.spaceNATInfo)
+}
+
+func (f *FakeAPI) spaceCreate(req *fakeRequest) (int, interface{}) {
+	name, _ := bodyString(req.body, "name")
+	if name == "" {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Name can't be blank.")
+	}
+	if f.lookup("space", name, "name") != nil {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Name "+name+" is already taken")
+	}
+
+	identity := firstNonEmpty(bodyIdentity(req.body, "team"), bodyIdentity(req.body, "organization"))
+	if identity == "" {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Team can't be blank.")
+	}
+	team := f.team(identity)
+
+	region, _ := bodyString(req.body, "region")
+	if region == "" {
+		region = "virginia"
+	}
+	shield, _ := bodyBool(req.body, "shield")
+	cidr, _ := bodyString(req.body, "cidr")
+	if cidr == "" {
+		cidr = "10.0.0.0/16"
+	}
+	dataCIDR, _ := bodyString(req.body, "data_cidr")
+	if dataCIDR == "" {
+		dataCIDR = "10.1.0.0/16"
+	}
+	generation, _ := bodyString(req.body, "generation")
+	if generation == "" {
+		generation = "cedar"
+	}
+
+	// Spaces allocate asynchronously, and are allocated when next read.
+	return http.StatusCreated, f.insert("space", fakeObject{
+		"id":           uuid.NewString(),
+		"name":         name,
+		"organization": map[string]interface{}{"name": team["name"]},
+		"team":         ref(team),
+		"region":       map[string]interface{}{"id": uuid.NewSHA1(uuid.NameSpaceURL, []byte(region)).String(), "name": region},
+		"generation":   ref(fakeGeneration(generation)),
+		"shield":       shield,
+		"state":        "allocating",
+		"cidr":         cidr,
+		"data_cidr":    dataCIDR,
+		"created_at":   fakeNow(),
+		"updated_at":   fakeNow(),
+	})
+}
+
+func (f *FakeAPI) space(identity string) fakeObject {
+	space := f.lookup("space", identity, "name")
+	if space != nil && space["state"] == "allocating" {
+		space["state"] = "allocated"
+	}
+	return space
+}
+
+func (f *FakeAPI) spaceInfo(req *fakeRequest) (int, interface{}) {
+	space := f.space(req.params[0])
+	if space == nil {
+		return fakeNotFound("space")
+	}
+	return http.StatusOK, space
+}
+
+func (f *FakeAPI) spaceList(req *fakeRequest) (int, interface{}) {
+	return http.StatusOK, f.filter("space", nil)
+}
+
+func (f *FakeAPI) spaceUpdate(req *fakeRequest) (int, interface{}) {
+	space := f.space(req.params[0])
+	if space == nil {
+		return fakeNotFound("space")
+	}
+	if name, ok := bodyString(req.body, "name"); ok && name != "" {
+		space["name"] = name
+	}
+	space["updated_at"] = fakeNow()
+	return http.StatusOK, space
+}
+
+func (f *FakeAPI) spaceDelete(req *fakeRequest) (int, interface{}) {
+	space := f.space(req.params[0])
+	if space == nil {
+		return fakeNotFound("space")
+	}
+	apps := f.filter("app", func(o fakeObject) bool { return refID(o, "space") == space["id"] })
+	if len(apps) > 0 {
+		return fakeError(http.StatusUnprocessableEntity, "invalid_params", "Space still contains apps.")
+	}
+	f.remove("space", space)
+	return http.StatusOK, space
+}
+
+func (f *FakeAPI) spaceNATInfo(req *fakeRequest) (int, interface{}) {
+	space := f.space(req.params[0])
+	if space == nil {
+		return fakeNotFound("space")
+	}
+	return http.StatusOK, fakeObject{
+		"sources":    []string{"203.0.113.10", "203.0.113.11"},
+		"state":      "enabled",
+		"created_at": space["created_at"],
+		"updated_at": space["updated_at"],
+	}
+}
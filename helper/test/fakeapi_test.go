@@ -0,0 +1,154 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+func newFakeAPIClient(t *testing.T) (*FakeAPI, *heroku.Service) {
+	t.Helper()
+
+	f, err := NewFakeAPI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(f.Close)
+
+	client := heroku.NewService(&http.Client{
+		Transport: &heroku.Transport{Password: FakeAPIKey},
+	})
+	client.URL = f.URL()
+
+	return f, client
+}
+
+func TestFakeAPI_AppConfigVarsAndReleases(t *testing.T) {
+	_, client := newFakeAPIClient(t)
+	ctx := context.Background()
+
+	name := "fake-app"
+	app, err := client.AppCreate(ctx, heroku.AppCreateOpts{Name: &name})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.AppCreate(ctx, heroku.AppCreateOpts{Name: &name}); err == nil {
+		t.Fatal("expected an error creating an app with a taken name")
+	}
+
+	value := "bar"
+	if _, err := client.ConfigVarUpdate(ctx, name, map[string]*string{"FOO": &value}); err != nil {
+		t.Fatal(err)
+	}
+
+	vars, err := client.ConfigVarInfoForApp(ctx, app.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := vars["FOO"]; v == nil || *v != "bar" {
+		t.Fatalf("expected FOO=bar, got %v", vars)
+	}
+
+	releases, err := client.ReleaseList(ctx, name, &heroku.ListRange{Descending: true, Field: "version", Max: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(releases) != 1 || releases[0].Version != 2 {
+		t.Fatalf("expected the latest release to be v2, got %+v", releases)
+	}
+
+	if _, err := client.AppDelete(ctx, app.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.AppInfo(ctx, name); err == nil {
+		t.Fatal("expected an error reading a deleted app")
+	}
+}
+
+func TestFakeAPI_BuildReleasesSlug(t *testing.T) {
+	_, client := newFakeAPIClient(t)
+	ctx := context.Background()
+
+	app, err := client.AppCreate(ctx, heroku.AppCreateOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source, err := client.SourceCreate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, _ := http.NewRequest(http.MethodPut, source.SourceBlob.PutURL, bytes.NewBufferString("source"))
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	opts := heroku.BuildCreateOpts{}
+	opts.SourceBlob.URL = &source.SourceBlob.GetURL
+	build, err := client.BuildCreate(ctx, app.ID, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if build.Status != "pending" {
+		t.Fatalf("expected a pending build, got %s", build.Status)
+	}
+
+	build, err = client.BuildInfo(ctx, app.ID, build.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if build.Status != "succeeded" || build.Slug == nil || build.Release == nil {
+		t.Fatalf("expected a succeeded build with a slug and release, got %+v", build)
+	}
+
+	quantity := 2
+	formation, err := client.FormationUpdate(ctx, app.ID, "web", heroku.FormationUpdateOpts{Quantity: &quantity})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if formation.Quantity != 2 {
+		t.Fatalf("expected 2 web dynos, got %d", formation.Quantity)
+	}
+
+	if _, err := client.FormationInfo(ctx, app.ID, "worker"); err == nil {
+		t.Fatal("expected an error reading a process type missing from the slug")
+	}
+}
+
+func TestFakeAPI_SpaceAllocates(t *testing.T) {
+	_, client := newFakeAPIClient(t)
+	ctx := context.Background()
+
+	space, err := client.SpaceCreate(ctx, heroku.SpaceCreateOpts{Name: "fake-space", Team: "fake-team"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if space.State != "allocating" {
+		t.Fatalf("expected an allocating space, got %s", space.State)
+	}
+
+	space, err = client.SpaceInfo(ctx, "fake-space")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if space.State != "allocated" || space.Organization.Name != "fake-team" {
+		t.Fatalf("expected an allocated space in fake-team, got %+v", space)
+	}
+}
+
+func TestFakeAPI_UnimplementedLink(t *testing.T) {
+	_, client := newFakeAPIClient(t)
+
+	_, err := client.CollaboratorList(context.Background(), "fake-app", nil)
+	var herr heroku.Error
+	if !errors.As(err, &herr) || herr.StatusCode != http.StatusNotImplemented {
+		t.Fatalf("expected a 501 error, got %v", err)
+	}
+}
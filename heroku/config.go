@@ -1,6 +1,7 @@
 package heroku
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/bgentry/go-netrc/netrc"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
@@ -27,8 +29,25 @@ const (
 	DefaultAddonCreateTimeout         = int64(20)
 	DefaultSetAddonConfigVarsInState  = true
 	DefaultSetAppAllConfigVarsInState = true
+
+	// Default retry policy, matching heroku-go's RoundTripWithRetryBackoff.
+	DefaultRetryInitialInterval = int64(30)
+	DefaultRetryMultiplier      = float64(2)
+	DefaultRetryMaxInterval     = int64(900)
+	DefaultRetryMaxElapsedTime  = int64(0)
+
+	// Default client-side rate limit, 0 for no fixed limit.
+	DefaultRateLimitRequestsPerSecond = float64(0)
 )
 
+// DefaultRetryableStatusCodes are the HTTP status codes retried by default.
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
 type Config struct {
 	Api       *heroku.Service
 	APIKey    string
@@ -37,7 +56,7 @@ type Config struct {
 	Headers   http.Header
 	URL       string
 
-	// Delays
+	// Delays, the upper bound on post-create readiness polling
 	PostAppCreateDelay    int64
 	PostDomainCreateDelay int64
 	PostSpaceCreateDelay  int64
@@ -45,6 +64,40 @@ type Config struct {
 	// Timeouts
 	AddonCreateTimeout int64
 
+	// Retries
+	RetryInitialInterval int64
+	RetryMultiplier      float64
+	RetryMaxInterval     int64
+	RetryMaxElapsedTime  int64
+	RetryableStatusCodes []int
+
+	// Rate limiting
+	RateLimitRequestsPerSecond float64
+	rateLimiter                *rateLimiter
+
+	// Lookups cached for the run, until the next write
+	readCache *readCache
+
+	// Generations available from the Platform API, loaded once per run
+	generations *generationList
+
+	// Resource types managing each app's buildpacks during the run
+	buildpackOwners *buildpackOwnership
+
+	// Network
+	ProxyURL              string
+	CABundleFile          string
+	InsecureSkipVerify    bool
+	ClientCertificateFile string
+	ClientKeyFile         string
+	transport             *http.Transport
+
+	// Defaults, inherited by resources which do not set them
+	DefaultTeam       string
+	DefaultRegion     string
+	DefaultSpace      string
+	DefaultGeneration string
+
 	// Customization
 	SetAddonConfigVarsInState  bool
 	SetAppAllConfigVarsInState bool
@@ -64,6 +117,12 @@ func NewConfig() *Config {
 		AddonCreateTimeout:         DefaultAddonCreateTimeout,
 		SetAddonConfigVarsInState:  DefaultSetAddonConfigVarsInState,
 		SetAppAllConfigVarsInState: DefaultSetAppAllConfigVarsInState,
+		RetryInitialInterval:       DefaultRetryInitialInterval,
+		RetryMultiplier:            DefaultRetryMultiplier,
+		RetryMaxInterval:           DefaultRetryMaxInterval,
+		RetryMaxElapsedTime:        DefaultRetryMaxElapsedTime,
+		RetryableStatusCodes:       DefaultRetryableStatusCodes,
+		RateLimitRequestsPerSecond: DefaultRateLimitRequestsPerSecond,
 	}
 	if logging.IsDebugOrHigher() {
 		config.DebugHTTP = true
@@ -72,6 +131,15 @@ func NewConfig() *Config {
 }
 
 func (c *Config) initializeAPI() (err error) {
+	c.rateLimiter = newRateLimiter(c.RateLimitRequestsPerSecond)
+	c.readCache = newReadCache()
+	c.generations = &generationList{}
+	c.buildpackOwners = &buildpackOwnership{}
+
+	if c.transport, err = c.newNetworkTransport(); err != nil {
+		return err
+	}
+
 	c.Api = heroku.NewService(&http.Client{
 		Transport: &heroku.Transport{
 			Username: c.Email,
@@ -79,20 +147,43 @@ func (c *Config) initializeAPI() (err error) {
 			UserAgent: fmt.Sprintf("%s terraform-provider-heroku/%s",
 				heroku.DefaultUserAgent, version.ProviderVersion),
 			AdditionalHeaders: c.Headers,
-			Debug:             c.DebugHTTP,
-			Transport:         heroku.RoundTripWithRetryBackoff{
-				// Configuration fields for ExponentialBackOff
-				// InitialIntervalSeconds: 30,
-				// RandomizationFactor:    0.25,
-				// Multiplier:             2,
-				// MaxIntervalSeconds:     900,
-				// MaxElapsedTimeSeconds:  0,
+			Transport: &readCacheTransport{
+				Cache: c.readCache,
+				Base: &retryTransport{
+					InitialInterval:      time.Duration(c.RetryInitialInterval) * time.Second,
+					Multiplier:           c.RetryMultiplier,
+					MaxInterval:          time.Duration(c.RetryMaxInterval) * time.Second,
+					MaxElapsedTime:       time.Duration(c.RetryMaxElapsedTime) * time.Second,
+					RetryableStatusCodes: c.RetryableStatusCodes,
+					Base: &loggingTransport{
+						Redactor:  logRedactor,
+						LogBodies: c.DebugHTTP,
+						Base: &rateLimitTransport{
+							Limiter: c.rateLimiter,
+							Base:    c.transport,
+						},
+					},
+				},
 			},
 		},
 	})
 
 	c.Api.URL = c.URL
 
+	// Mask the API key wherever it might be logged, and everything logged
+	// through the standard library log package.
+	logRedactor.Learn(c.APIKey)
+	redactStandardLog()
+
+	c.rateLimiter.seed = func(ctx context.Context) error {
+		rateLimit, err := c.Api.RateLimitInfo(ctx)
+		if err != nil {
+			return err
+		}
+		c.rateLimiter.Observe(rateLimit.Remaining)
+		return nil
+	}
+
 	log.Printf("[INFO] Heroku Client configured for user: %s", c.Email)
 
 	return
@@ -114,6 +205,12 @@ func (c *Config) applySchema(d *schema.ResourceData) (err error) {
 		c.URL = url.(string)
 	}
 
+	c.ProxyURL = d.Get("proxy_url").(string)
+	c.CABundleFile = d.Get("ca_bundle_file").(string)
+	c.InsecureSkipVerify = d.Get("insecure_skip_verify").(bool)
+	c.ClientCertificateFile = d.Get("client_certificate_file").(string)
+	c.ClientKeyFile = d.Get("client_key_file").(string)
+
 	if v, ok := d.GetOk("customizations"); ok {
 		vL := v.([]interface{})
 		if len(vL) > 1 {
@@ -163,6 +260,82 @@ func (c *Config) applySchema(d *schema.ResourceData) (err error) {
 		}
 	}
 
+	if v, ok := d.GetOk("retry"); ok {
+		vL := v.([]interface{})
+		if len(vL) > 1 {
+			return fmt.Errorf("provider configuration error: only one retry block is permitted")
+		}
+
+		for _, v := range vL {
+			retryConfig, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if v, ok := retryConfig["initial_interval"].(int); ok {
+				c.RetryInitialInterval = int64(v)
+			}
+			if v, ok := retryConfig["multiplier"].(float64); ok {
+				c.RetryMultiplier = v
+			}
+			if v, ok := retryConfig["max_interval"].(int); ok {
+				c.RetryMaxInterval = int64(v)
+			}
+			if v, ok := retryConfig["max_elapsed_time"].(int); ok {
+				c.RetryMaxElapsedTime = int64(v)
+			}
+			if v, ok := retryConfig["retryable_status_codes"].([]interface{}); ok && len(v) > 0 {
+				codes := make([]int, 0, len(v))
+				for _, code := range v {
+					codes = append(codes, code.(int))
+				}
+				c.RetryableStatusCodes = codes
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("rate_limit"); ok {
+		vL := v.([]interface{})
+		if len(vL) > 1 {
+			return fmt.Errorf("provider configuration error: only one rate_limit block is permitted")
+		}
+
+		for _, v := range vL {
+			rateLimitConfig, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if v, ok := rateLimitConfig["requests_per_second"].(float64); ok {
+				c.RateLimitRequestsPerSecond = v
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("defaults"); ok {
+		vL := v.([]interface{})
+		if len(vL) > 1 {
+			return fmt.Errorf("provider configuration error: only one defaults block is permitted")
+		}
+
+		for _, v := range vL {
+			defaultsConfig, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if v, ok := defaultsConfig["team"].(string); ok {
+				c.DefaultTeam = v
+			}
+			if v, ok := defaultsConfig["region"].(string); ok {
+				c.DefaultRegion = v
+			}
+			if v, ok := defaultsConfig["space"].(string); ok {
+				c.DefaultSpace = v
+			}
+			if v, ok := defaultsConfig["generation"].(string); ok {
+				c.DefaultGeneration = v
+			}
+		}
+	}
+
 	return
 }
 
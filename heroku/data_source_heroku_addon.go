@@ -1,12 +1,15 @@
 package heroku
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceHerokuAddon() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceHerokuAddonRead,
+		ReadContext: dataSourceHerokuAddonRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -44,14 +47,14 @@ func dataSourceHerokuAddon() *schema.Resource {
 	}
 }
 
-func dataSourceHerokuAddonRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceHerokuAddonRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Config).Api
 
 	name := d.Get("name").(string)
 
-	addon, err := resourceHerokuAddonRetrieve(name, client)
+	addon, err := resourceHerokuAddonRetrieve(ctx, name, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(addon.ID)
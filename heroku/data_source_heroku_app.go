@@ -2,15 +2,15 @@ package heroku
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	heroku "github.com/heroku/heroku-go/v6"
 )
 
 func dataSourceHerokuApp() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceHerokuAppRead,
+		ReadContext: dataSourceHerokuAppRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -120,13 +120,13 @@ func dataSourceHerokuApp() *schema.Resource {
 	}
 }
 
-func dataSourceHerokuAppRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceHerokuAppRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Config).Api
 
 	name := d.Get("name").(string)
-	app, err := resourceHerokuAppRetrieve(name, client)
+	app, err := resourceHerokuAppRetrieve(ctx, name, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(app.App.ID)
@@ -134,13 +134,13 @@ func dataSourceHerokuAppRead(d *schema.ResourceData, m interface{}) error {
 	if app.IsTeamApp {
 		setErr := setTeamDetails(d, app)
 		if setErr != nil {
-			return setErr
+			return diag.FromErr(setErr)
 		}
 	}
 
 	setErr := setAppDetails(d, app)
 	if setErr != nil {
-		return setErr
+		return diag.FromErr(setErr)
 	}
 
 	d.Set("buildpacks", app.Buildpacks)
@@ -152,9 +152,9 @@ func dataSourceHerokuAppRead(d *schema.ResourceData, m interface{}) error {
 		Max:        200,
 		Descending: true,
 	}
-	releases, err := client.ReleaseList(context.Background(), app.App.ID, &releaseRange)
+	releases, err := client.ReleaseList(ctx, app.App.ID, &releaseRange)
 	if err != nil {
-		return fmt.Errorf("failed to fetch releases for app '%s': %s", name, err)
+		return diag.Errorf("failed to fetch releases for app '%s': %s", name, err)
 	}
 	for _, r := range releases {
 		if r.Status == "succeeded" {
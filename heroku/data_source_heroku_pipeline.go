@@ -2,12 +2,14 @@ package heroku
 
 import (
 	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceHerokuPipeline() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceHerokuPipelineRead,
+		ReadContext: dataSourceHerokuPipelineRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -27,14 +29,14 @@ func dataSourceHerokuPipeline() *schema.Resource {
 	}
 }
 
-func dataSourceHerokuPipelineRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceHerokuPipelineRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Config).Api
 
 	name := d.Get("name").(string)
 
-	pipeline, getErr := client.PipelineInfo(context.TODO(), name)
+	pipeline, getErr := client.PipelineInfo(ctx, name)
 	if getErr != nil {
-		return getErr
+		return diag.FromErr(getErr)
 	}
 
 	d.SetId(pipeline.ID)
@@ -1,12 +1,15 @@
 package heroku
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceHerokuSpace() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceHerokuSpaceRead,
+		ReadContext: dataSourceHerokuSpaceRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -71,13 +74,13 @@ func dataSourceHerokuSpace() *schema.Resource {
 	}
 }
 
-func dataSourceHerokuSpaceRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceHerokuSpaceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Config).Api
 
 	name := d.Get("name").(string)
-	spaceRaw, _, err := SpaceStateRefreshFunc(client, name)()
+	spaceRaw, _, err := SpaceStateRefreshFunc(ctx, client, name)()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	space := spaceRaw.(*spaceWithNAT)
@@ -87,5 +90,5 @@ func dataSourceHerokuSpaceRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("state", space.State)
 	d.Set("shield", space.Shield)
 
-	return resourceHerokuSpaceRead(d, m)
+	return resourceHerokuSpaceRead(ctx, d, m)
 }
@@ -3,12 +3,13 @@ package heroku
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceHerokuSpacePeeringInfo() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceHerokuSpacePeeringInfoRead,
+		ReadContext: dataSourceHerokuSpacePeeringInfoRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -54,15 +55,15 @@ func dataSourceHerokuSpacePeeringInfo() *schema.Resource {
 	}
 }
 
-func dataSourceHerokuSpacePeeringInfoRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceHerokuSpacePeeringInfoRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Config).Api
 
 	name := d.Get("name").(string)
 	d.SetId(name)
 
-	peeringInfo, err := client.PeeringInfoInfo(context.TODO(), name)
+	peeringInfo, err := client.PeeringInfoInfo(ctx, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("aws_account_id", peeringInfo.AwsAccountID)
@@ -2,12 +2,14 @@ package heroku
 
 import (
 	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceHerokuTeam() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceHerokuTeamRead,
+		ReadContext: dataSourceHerokuTeamRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -37,14 +39,14 @@ func dataSourceHerokuTeam() *schema.Resource {
 	}
 }
 
-func dataSourceHerokuTeamRead(d *schema.ResourceData, m interface{}) error {
+func dataSourceHerokuTeamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Config).Api
 
 	name := d.Get("name").(string)
 
-	team, err := client.TeamInfo(context.TODO(), name)
+	team, err := client.TeamInfo(ctx, name)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(team.ID)
@@ -56,5 +58,5 @@ func dataSourceHerokuTeamRead(d *schema.ResourceData, m interface{}) error {
 	setErr = d.Set("provisioned_licenses", team.ProvisionedLicenses)
 	setErr = d.Set("type", team.Type)
 
-	return setErr
+	return diag.FromErr(setErr)
 }
@@ -87,7 +87,7 @@ func dataSourceHerokuTeamMembersRead(ctx context.Context, d *schema.ResourceData
 		roles = append(roles, r.(string))
 	}
 
-	teamMembers, listErr := client.TeamMemberList(context.TODO(), teamName,
+	teamMembers, listErr := client.TeamMemberList(ctx, teamName,
 		&heroku.ListRange{
 			Field:      "id",
 			Max:        1000,
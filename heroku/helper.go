@@ -2,11 +2,16 @@ package heroku
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	heroku "github.com/heroku/heroku-go/v6"
@@ -60,8 +65,8 @@ func getPipelineID(d *schema.ResourceData) string {
 	return pipelineID
 }
 
-func doesHerokuAppExist(appName string, client *heroku.Service) (*heroku.App, error) {
-	app, err := client.AppInfo(context.TODO(), appName)
+func doesHerokuAppExist(ctx context.Context, appName string, client *heroku.Service) (*heroku.App, error) {
+	app, err := client.AppInfo(ctx, appName)
 
 	if err != nil {
 		log.Println(err)
@@ -70,6 +75,117 @@ func doesHerokuAppExist(appName string, client *heroku.Service) (*heroku.App, er
 	return app, nil
 }
 
+// waitForReadiness polls refresh until it reports the "ready" state. The wait
+// is bounded by timeout; running out of time is logged rather than returned,
+// as readiness only guards against Heroku's eventually consistent data model.
+func waitForReadiness(ctx context.Context, description string, refresh resource.StateRefreshFunc, timeout time.Duration) {
+	if timeout <= 0 {
+		return
+	}
+
+	log.Printf("[DEBUG] Waiting up to %s for %s to be ready", timeout, description)
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"pending"},
+		Target:       []string{"ready"},
+		Refresh:      refresh,
+		Timeout:      timeout,
+		PollInterval: 1 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		log.Printf("[WARN] Could not confirm %s is ready, continuing: %s", description, err)
+	}
+}
+
+// rawConfigReader is a schema.ResourceData or schema.ResourceDiff, which both
+// expose the configuration Terraform sent.
+type rawConfigReader interface {
+	GetRawConfig() cty.Value
+}
+
+// isUnsetInConfig reports whether key is absent from the resource's
+// configuration, as opposed to being set, even to an empty or unknown value.
+func isUnsetInConfig(d rawConfigReader, key string) bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+
+	v := config.GetAttr(key)
+	if v.IsNull() {
+		return true
+	}
+
+	// Blocks are never null, only empty.
+	return v.IsKnown() && v.CanIterateElements() && v.LengthInt() == 0
+}
+
+// isSetInRawConfig reports whether key is set in the resource's configuration,
+// even to its zero value. Unlike !isUnsetInConfig, it is false when the
+// configuration is unavailable.
+func isSetInRawConfig(d rawConfigReader, key string) bool {
+	config := d.GetRawConfig()
+	return !config.IsNull() && config.IsKnown() && !isUnsetInConfig(d, key)
+}
+
+// setProviderDefault plans value for key when the resource's configuration
+// does not set key, so that values inherited from the provider's defaults
+// block show in the plan.
+func setProviderDefault(d *schema.ResourceDiff, key string, value interface{}) error {
+	if !isUnsetInConfig(d, key) {
+		return nil
+	}
+	return d.SetNew(key, value)
+}
+
+// diagnosticsError returns the errors in diags as an error, for importers and
+// other callers which return errors rather than diagnostics.
+func diagnosticsError(diags diag.Diagnostics) error {
+	var msgs []string
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if d.Detail != "" {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", d.Summary, d.Detail))
+		} else {
+			msgs = append(msgs, d.Summary)
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(msgs, "; "))
+}
+
+// isNotFoundError reports whether err is a Heroku API 404 response.
+func isNotFoundError(err error) bool {
+	var herr heroku.Error
+	return errors.As(err, &herr) && herr.StatusCode == 404
+}
+
+// listPageSize is the largest page of results the Platform API returns.
+const listPageSize = 1000
+
+// listAllByID lists every page of a Platform API list ordered by id. Each page
+// starts after the last id of the previous page, as its Next-Range header
+// would, until a page is not full.
+func listAllByID[T any](list func(lr *heroku.ListRange) ([]T, error), id func(T) string) ([]T, error) {
+	var all []T
+	lr := &heroku.ListRange{Field: "id", Max: listPageSize}
+	for {
+		page, err := list(lr)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		if len(page) < lr.Max {
+			return all, nil
+		}
+		lr = &heroku.ListRange{Field: "id", Max: listPageSize, FirstID: "]" + id(page[len(page)-1])}
+	}
+}
+
 func buildCompositeID(a, b string) string {
 	return fmt.Sprintf("%s:%s", a, b)
 }
@@ -153,3 +269,14 @@ func upgradeAppToAppID(ctx context.Context, rawState map[string]interface{}, met
 
 	return rawState, nil
 }
+
+// remainingTimeout returns the time left before the deadline of ctx, which
+// Terraform sets from the resource's timeouts, for waits within helpers that
+// have no access to the resource's data. It returns fallback when ctx has no
+// deadline.
+func remainingTimeout(ctx context.Context, fallback time.Duration) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		return time.Until(deadline)
+	}
+	return fallback
+}
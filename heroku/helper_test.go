@@ -4,10 +4,14 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	heroku "github.com/heroku/heroku-go/v6"
 )
 
 func TestHelper_MigrateAppToAppID(t *testing.T) {
@@ -80,3 +84,37 @@ func TestHelper_UpgradeAppToAppID(t *testing.T) {
 		t.Fatalf("expected new 'app_id' attribute: %s, got: %s", expectedID, actual["app_id"])
 	}
 }
+
+func TestHelper_ListAllByID(t *testing.T) {
+	var ranges []string
+	list := func(lr *heroku.ListRange) ([]int, error) {
+		req, _ := http.NewRequest(http.MethodGet, "/", nil)
+		lr.SetHeader(req)
+		ranges = append(ranges, req.Header.Get("Range"))
+
+		// Two full pages, then a partial one.
+		start := 0
+		if lr.FirstID != "" {
+			start, _ = strconv.Atoi(strings.TrimPrefix(lr.FirstID, "]"))
+			start++
+		}
+		page := []int{}
+		for i := start; i < 2*listPageSize+5 && len(page) < lr.Max; i++ {
+			page = append(page, i)
+		}
+		return page, nil
+	}
+
+	all, err := listAllByID(list, strconv.Itoa)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2*listPageSize+5 || all[len(all)-1] != 2*listPageSize+4 {
+		t.Fatalf("got %d results ending with %d", len(all), all[len(all)-1])
+	}
+
+	want := []string{"id ..; max=1000", "id ]999..; max=1000", "id ]1999..; max=1000"}
+	if !reflect.DeepEqual(ranges, want) {
+		t.Fatalf("got ranges %q, want %q", ranges, want)
+	}
+}
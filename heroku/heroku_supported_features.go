@@ -1,11 +1,26 @@
 package heroku
 
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
 // Feature matrix system for graceful handling of generation differences
 // between Cedar and Fir generations in Terraform Provider Heroku.
 
+// generationsLoadTimeout bounds how long loading generations from the
+// Platform API may take before falling back to those in featureMatrix.
+const generationsLoadTimeout = 30 * time.Second
+
 // featureMatrix defines which features are supported for each generation and resource type.
-// This is the single source of truth for feature availability based on the
-// unsupported features data from Platform API's 3.sdk Generation endpoints.
+// It mirrors the unsupported features data from Platform API's 3.sdk Generation endpoints,
+// and is used whenever that data could not be loaded by Config.IsFeatureSupported.
 var featureMatrix = map[string]map[string]map[string]bool{
 	"cedar": {
 		"space": {
@@ -65,8 +80,8 @@ var featureMatrix = map[string]map[string]map[string]bool{
 	},
 }
 
-// IsFeatureSupported checks if a feature is supported for a given generation and resource type.
-// Returns true if the feature is supported, false otherwise.
+// IsFeatureSupported checks if a feature is supported for a given generation and resource type,
+// according to featureMatrix. Returns true if the feature is supported, false otherwise.
 //
 // Parameters:
 //   - generation: "cedar" or "fir"
@@ -90,3 +105,149 @@ func IsFeatureSupported(generation, resourceType, feature string) bool {
 	// Default to false for any unknown generation/resource/feature combination
 	return false
 }
+
+// hasFeatureMatrix reports whether featureMatrix describes the generation.
+func hasFeatureMatrix(generation string) bool {
+	_, ok := featureMatrix[generation]
+	return ok
+}
+
+// IsFeatureSupported checks if a feature is supported for a given generation
+// and resource type, from the unsupported features reported by the Platform
+// API for the generation. When they could not be loaded, or the API does not
+// report them, the package level IsFeatureSupported answers from featureMatrix.
+func (c *Config) IsFeatureSupported(ctx context.Context, generation, resourceType, feature string) bool {
+	if unsupported := c.loadedGenerations(ctx)[generation]; unsupported != nil && isKnownFeature(resourceType, feature) {
+		// Features may be reported by name, or prefixed by resource type
+		// such as space_outbound_ips.
+		return !unsupported[feature] && !unsupported[resourceType+"_"+feature]
+	}
+
+	return IsFeatureSupported(generation, resourceType, feature)
+}
+
+// canValidateFeatures reports whether the features of the generation are known,
+// either from the Platform API or from featureMatrix. The features of other
+// generations cannot be validated by the provider, so they are left to the
+// Platform API.
+func (c *Config) canValidateFeatures(ctx context.Context, generation string) bool {
+	return c.loadedGenerations(ctx)[generation] != nil || hasFeatureMatrix(generation)
+}
+
+// isKnownFeature reports whether featureMatrix describes the feature for the
+// resource type in any generation. Only those features are answered from the
+// Platform API, as the provider does not know how to handle others.
+func isKnownFeature(resourceType, feature string) bool {
+	for _, resources := range featureMatrix {
+		if _, ok := resources[resourceType][feature]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// generationList holds the generations available from the Platform API,
+// loaded once per provider run.
+type generationList struct {
+	once sync.Once
+	// unsupported maps the name of each generation to the names of the
+	// features it does not support, or to nil when the API does not report
+	// them. It is nil when the generations could not be loaded.
+	unsupported map[string]map[string]bool
+}
+
+// generationInfo is a heroku.Generation including the features it does not
+// support, which heroku-go does not decode.
+type generationInfo struct {
+	Name                string            `json:"name"`
+	UnsupportedFeatures []json.RawMessage `json:"unsupported_features"`
+}
+
+// loadedGenerations returns the generations loaded from the Platform API,
+// loading them on first use, or nil when they could not be loaded.
+func (c *Config) loadedGenerations(ctx context.Context) map[string]map[string]bool {
+	if c == nil || c.generations == nil {
+		return nil
+	}
+
+	c.generations.once.Do(func() {
+		c.generations.unsupported = loadGenerations(ctx, c.Api)
+	})
+	return c.generations.unsupported
+}
+
+// knownGenerations returns the sorted names of the generations available from
+// the Platform API. When they cannot be loaded, the generations in
+// featureMatrix are returned instead.
+func (c *Config) knownGenerations(ctx context.Context) []string {
+	generations := c.loadedGenerations(ctx)
+	if generations == nil {
+		generations = make(map[string]map[string]bool, len(featureMatrix))
+		for name := range featureMatrix {
+			generations[name] = nil
+		}
+	}
+
+	names := make([]string, 0, len(generations))
+	for name := range generations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isKnownGeneration reports whether the generation is available, per
+// knownGenerations.
+func (c *Config) isKnownGeneration(ctx context.Context, generation string) bool {
+	for _, name := range c.knownGenerations(ctx) {
+		if name == generation {
+			return true
+		}
+	}
+	return false
+}
+
+// loadGenerations returns the unsupported features of each generation listed
+// by the Platform API, or nil when they cannot be listed.
+func loadGenerations(ctx context.Context, client *heroku.Service) map[string]map[string]bool {
+	ctx, cancel := context.WithTimeout(ctx, generationsLoadTimeout)
+	defer cancel()
+
+	var generations []generationInfo
+	if err := client.Get(ctx, &generations, "/generations", nil, nil); err != nil {
+		log.Printf("[WARN] Unable to load generations from the Heroku API, using built-in feature matrix: %s", err)
+		return nil
+	}
+
+	unsupported := make(map[string]map[string]bool, len(generations))
+	for _, generation := range generations {
+		unsupported[generation.Name] = parseUnsupportedFeatures(generation.UnsupportedFeatures)
+	}
+
+	log.Printf("[DEBUG] Loaded %d generations from the Heroku API", len(unsupported))
+	return unsupported
+}
+
+// parseUnsupportedFeatures accepts unsupported features listed either by name
+// or as objects with a name. It returns nil when none are listed.
+func parseUnsupportedFeatures(raw []json.RawMessage) map[string]bool {
+	if raw == nil {
+		return nil
+	}
+
+	features := make(map[string]bool, len(raw))
+	for _, r := range raw {
+		var name string
+		if err := json.Unmarshal(r, &name); err != nil {
+			var feature struct {
+				Name string `json:"name"`
+			}
+			if err := json.Unmarshal(r, &feature); err != nil {
+				continue
+			}
+			name = feature.Name
+		}
+		features[name] = true
+	}
+	return features
+}
@@ -1,7 +1,13 @@
 package heroku
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
+
+	heroku "github.com/heroku/heroku-go/v6"
 )
 
 func TestIsFeatureSupported(t *testing.T) {
@@ -226,3 +232,131 @@ func TestFeatureMatrixConsistency(t *testing.T) {
 		t.Error("Fir space shield must be unsupported")
 	}
 }
+
+func TestConfigKnownGenerations(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/generations" {
+			t.Errorf("got path %q, want /generations", r.URL.Path)
+		}
+		calls++
+		w.Write([]byte(`[{"name":"cedar"},{"name":"next"},{"name":"fir"}]`))
+	}))
+	defer srv.Close()
+
+	config := &Config{Api: heroku.NewService(http.DefaultClient), generations: &generationList{}}
+	config.Api.URL = srv.URL
+
+	if names := config.knownGenerations(context.Background()); !reflect.DeepEqual(names, []string{"cedar", "fir", "next"}) {
+		t.Fatalf("got generations %v", names)
+	}
+	if !config.isKnownGeneration(context.Background(), "next") {
+		t.Fatal("expected next to be a known generation")
+	}
+	if config.isKnownGeneration(context.Background(), "unknown") {
+		t.Fatal("expected unknown not to be a known generation")
+	}
+	if calls != 1 {
+		t.Fatalf("got %d requests, expected the generations to be loaded once", calls)
+	}
+
+	// Feature support of a generation without a featureMatrix entry is
+	// unknown, so it is never reported as supported.
+	if hasFeatureMatrix("next") || IsFeatureSupported("next", "space", "shield") {
+		t.Fatal("expected no feature support for a generation missing from featureMatrix")
+	}
+	if IsFeatureSupported("fir", "space", "shield") {
+		t.Fatal("expected featureMatrix to decide the features of known generations")
+	}
+}
+
+func TestConfigKnownGenerations_LoadFailureFallsBack(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"id":"not_found","message":"not found"}`))
+	}))
+	defer srv.Close()
+
+	config := &Config{Api: heroku.NewService(&http.Client{Transport: &heroku.Transport{}}), generations: &generationList{}}
+	config.Api.URL = srv.URL
+
+	if names := config.knownGenerations(context.Background()); !reflect.DeepEqual(names, []string{"cedar", "fir"}) {
+		t.Fatalf("got generations %v, expected those of featureMatrix", names)
+	}
+	if config.isKnownGeneration(context.Background(), "next") {
+		t.Fatal("expected only the generations of featureMatrix after a failed load")
+	}
+}
+
+func TestConfigIsFeatureSupported(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"name":"cedar","unsupported_features":["shield",{"name":"space_outbound_ips"}]},
+			{"name":"fir"},
+			{"name":"next","unsupported_features":[]}
+		]`))
+	}))
+	defer srv.Close()
+
+	config := &Config{Api: heroku.NewService(http.DefaultClient), generations: &generationList{}}
+	config.Api.URL = srv.URL
+	ctx := context.Background()
+
+	testCases := []struct {
+		generation   string
+		resourceType string
+		feature      string
+		expected     bool
+	}{
+		// Disabled by the API although featureMatrix allows them.
+		{"cedar", "space", "shield", false},
+		{"cedar", "space", "outbound_ips", false},
+		{"cedar", "space", "private_vpn", true},
+		// Features unknown to the provider are never supported.
+		{"cedar", "space", "unknown", false},
+		// The API does not report fir's unsupported features.
+		{"fir", "space", "shield", false},
+		{"fir", "app", "otel", true},
+		// A generation missing from featureMatrix.
+		{"next", "space", "shield", true},
+		{"next", "app", "buildpacks", true},
+	}
+
+	for _, tc := range testCases {
+		if got := config.IsFeatureSupported(ctx, tc.generation, tc.resourceType, tc.feature); got != tc.expected {
+			t.Errorf("IsFeatureSupported(%q, %q, %q) = %v, expected %v", tc.generation, tc.resourceType, tc.feature, got, tc.expected)
+		}
+	}
+
+	if !config.canValidateFeatures(ctx, "next") {
+		t.Error("expected the features of next to be known from the API")
+	}
+	if err := validateBuildpacksForGeneration(ctx, config, "cedar"); err != nil {
+		t.Errorf("expected buildpacks to be allowed for cedar, got %s", err)
+	}
+}
+
+func TestConfigIsFeatureSupported_LoadFailureFallsBack(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"id":"unavailable","message":"unavailable"}`))
+	}))
+	defer srv.Close()
+
+	config := &Config{Api: heroku.NewService(&http.Client{Transport: &heroku.Transport{}}), generations: &generationList{}}
+	config.Api.URL = srv.URL
+	ctx := context.Background()
+
+	if !config.IsFeatureSupported(ctx, "cedar", "space", "shield") {
+		t.Error("expected featureMatrix to allow cedar shield spaces")
+	}
+	if config.IsFeatureSupported(ctx, "fir", "space", "shield") {
+		t.Error("expected featureMatrix to disallow fir shield spaces")
+	}
+	if config.canValidateFeatures(ctx, "next") {
+		t.Error("expected the features of next to be unknown")
+	}
+	if err := validateBuildpacksForGeneration(ctx, config, "fir"); err == nil {
+		t.Error("expected buildpacks to be rejected for fir")
+	}
+}
@@ -0,0 +1,374 @@
+package heroku
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// apiLogSubsystem is the tflog subsystem of Heroku API requests.
+	apiLogSubsystem = "heroku_api"
+
+	redactedValue = "***"
+
+	// minLearnedSecretLength avoids masking short, common values, such as
+	// "true" or "1", everywhere they appear in the logs.
+	minLearnedSecretLength = 8
+)
+
+// sensitiveLogKeys are the field and JSON body keys whose values are always
+// masked.
+var sensitiveLogKeys = []string{
+	"access_token",
+	"api_key",
+	"authorization",
+	"client_secret",
+	"password",
+	"private_key",
+	"refresh_token",
+	"secret",
+	"sensitive_config_vars",
+	"sensitive_url",
+	"token",
+}
+
+// sensitiveBodyKeysByPath masks further JSON body keys in the requests and
+// responses of matching API paths.
+var sensitiveBodyKeysByPath = map[*regexp.Regexp][]string{
+	regexp.MustCompile(`/log-drains(/|$)`):       {"url"},
+	regexp.MustCompile(`/telemetry-drains(/|$)`): {"headers"},
+}
+
+// configVarPaths are the API paths whose JSON bodies are maps of config var
+// names to values, all of which are masked.
+var configVarPaths = regexp.MustCompile(`/config-vars$`)
+
+// configVarBodyKeysByPath are the JSON body keys, in the requests and responses
+// of matching API paths, whose values are maps of config var names to values.
+// As for configVarPaths, all of the values are masked.
+var configVarBodyKeysByPath = map[*regexp.Regexp][]string{
+	regexp.MustCompile(`/app-setups(/|$)`):  {"env"},
+	regexp.MustCompile(`/review-apps(/|$)`): {"environment"},
+}
+
+// secretPatterns match secrets by their shape, wherever they appear.
+var secretPatterns = []*regexp.Regexp{
+	// Credentials in URLs, such as DATABASE_URL or drain URLs
+	regexp.MustCompile(`(?i)([a-z][a-z0-9+.-]*://[^/@\s:"']*:)[^/@\s"']+(@)`),
+	// Authorization header values
+	regexp.MustCompile(`(?i)((?:Bearer|Basic)\s+)[A-Za-z0-9+/=._~-]+`),
+	// Signed URL and token query parameters
+	regexp.MustCompile(`(?i)((?:X-Amz-Signature|X-Amz-Credential|X-Amz-Security-Token|Signature|access_token|token)=)[^&\s"']+`),
+	// Heroku API keys
+	regexp.MustCompile(`(HRKU-)[A-Za-z0-9_-]+`),
+	// PEM encoded private keys
+	regexp.MustCompile(`(-----BEGIN [A-Z ]*PRIVATE KEY-----)[\s\S]*?(-----END [A-Z ]*PRIVATE KEY-----)`),
+}
+
+// logRedactor masks secrets in everything the provider logs, both through
+// tflog and the standard library log package.
+var logRedactor = newRedactor()
+
+// redactor masks secrets, matched either by their shape or as values learned
+// from sensitive fields of API requests and responses.
+type redactor struct {
+	mu      sync.RWMutex
+	secrets map[string]struct{}
+	ordered []string
+}
+
+func newRedactor() *redactor {
+	return &redactor{secrets: make(map[string]struct{})}
+}
+
+// Learn registers a secret value, to mask wherever it appears.
+func (r *redactor) Learn(secret string) {
+	if len(secret) < minLearnedSecretLength {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.secrets[secret]; ok {
+		return
+	}
+	r.secrets[secret] = struct{}{}
+	r.ordered = append(r.ordered, secret)
+
+	// Mask longer secrets first, in case one contains another.
+	sort.Slice(r.ordered, func(i, j int) bool { return len(r.ordered[i]) > len(r.ordered[j]) })
+}
+
+// Redact masks the secrets in s.
+func (r *redactor) Redact(s string) string {
+	r.mu.RLock()
+	for _, secret := range r.ordered {
+		s = strings.ReplaceAll(s, secret, redactedValue)
+	}
+	r.mu.RUnlock()
+
+	for _, pattern := range secretPatterns {
+		if pattern.NumSubexp() == 2 {
+			s = pattern.ReplaceAllString(s, "${1}"+redactedValue+"${2}")
+		} else {
+			s = pattern.ReplaceAllString(s, "${1}"+redactedValue)
+		}
+	}
+	return s
+}
+
+// RedactBody masks the secrets in a JSON request or response body of the API
+// path, learning the masked values. Bodies which are not JSON are masked by
+// shape only.
+func (r *redactor) RedactBody(path string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return r.Redact(string(body))
+	}
+
+	keys := make(map[string]bool, len(sensitiveLogKeys))
+	for _, k := range sensitiveLogKeys {
+		keys[k] = true
+	}
+	for pattern, pathKeys := range sensitiveBodyKeysByPath {
+		if pattern.MatchString(path) {
+			for _, k := range pathKeys {
+				keys[k] = true
+			}
+		}
+	}
+	varKeys := make(map[string]bool)
+	for pattern, pathKeys := range configVarBodyKeysByPath {
+		if pattern.MatchString(path) {
+			for _, k := range pathKeys {
+				varKeys[k] = true
+			}
+		}
+	}
+
+	if vars, ok := v.(map[string]interface{}); ok && configVarPaths.MatchString(path) {
+		r.redactConfigVars(vars)
+	} else {
+		v = r.redactValue(v, keys, varKeys)
+	}
+
+	redacted, err := json.Marshal(v)
+	if err != nil {
+		return r.Redact(string(body))
+	}
+	return r.Redact(string(redacted))
+}
+
+func (r *redactor) redactValue(v interface{}, keys, varKeys map[string]bool) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			if keys[strings.ToLower(k)] && value != nil {
+				r.learnValue(value)
+				v[k] = redactedValue
+				continue
+			}
+			if vars, ok := value.(map[string]interface{}); ok && varKeys[strings.ToLower(k)] {
+				r.redactConfigVars(vars)
+				continue
+			}
+			v[k] = r.redactValue(value, keys, varKeys)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = r.redactValue(value, keys, varKeys)
+		}
+	}
+	return v
+}
+
+// redactConfigVars masks the values of a map of config var names to values,
+// keeping the names.
+func (r *redactor) redactConfigVars(vars map[string]interface{}) {
+	for k, value := range vars {
+		if value != nil {
+			r.learnValue(value)
+			vars[k] = redactedValue
+		}
+	}
+}
+
+func (r *redactor) learnValue(v interface{}) {
+	switch v := v.(type) {
+	case string:
+		r.Learn(v)
+	case map[string]interface{}:
+		for _, value := range v {
+			r.learnValue(value)
+		}
+	case []interface{}:
+		for _, value := range v {
+			r.learnValue(value)
+		}
+	}
+}
+
+// redactingWriter masks secrets in everything written through it, such as
+// the output of the standard library log package.
+type redactingWriter struct {
+	Writer   io.Writer
+	Redactor *redactor
+}
+
+func (w *redactingWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(w.Writer, w.Redactor.Redact(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+var redactStandardLogOnce sync.Once
+
+// redactStandardLog masks secrets in the output of the standard library log
+// package, which most of the provider still logs through.
+func redactStandardLog() {
+	redactStandardLogOnce.Do(func() {
+		log.SetOutput(&redactingWriter{Writer: log.Writer(), Redactor: logRedactor})
+	})
+}
+
+// maskedLoggingContext adds a tflog subsystem to ctx which masks secrets.
+func maskedLoggingContext(ctx context.Context, subsystem string) context.Context {
+	ctx = tflog.NewSubsystem(ctx, subsystem)
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subsystem, sensitiveLogKeys...)
+	ctx = tflog.SubsystemMaskAllFieldValuesRegexes(ctx, subsystem, secretPatterns...)
+	ctx = tflog.SubsystemMaskMessageRegexes(ctx, subsystem, secretPatterns...)
+	return ctx
+}
+
+type loggingSubsystemKey struct{}
+
+// resourceLoggingContext scopes logging in ctx to the subsystem of a resource
+// or data source type, such as "heroku_app".
+func resourceLoggingContext(ctx context.Context, resourceType string) context.Context {
+	ctx = maskedLoggingContext(ctx, resourceType)
+	ctx = maskedLoggingContext(ctx, apiLogSubsystem)
+	ctx = tflog.SubsystemSetField(ctx, apiLogSubsystem, "tf_resource_type", resourceType)
+	return context.WithValue(ctx, loggingSubsystemKey{}, resourceType)
+}
+
+// loggingSubsystem returns the resource subsystem of ctx, if any.
+func loggingSubsystem(ctx context.Context) (string, bool) {
+	subsystem, ok := ctx.Value(loggingSubsystemKey{}).(string)
+	return subsystem, ok
+}
+
+func logDebug(ctx context.Context, msg string, fields ...map[string]interface{}) {
+	if subsystem, ok := loggingSubsystem(ctx); ok {
+		tflog.SubsystemDebug(ctx, subsystem, logRedactor.Redact(msg), fields...)
+		return
+	}
+	tflog.Debug(ctx, logRedactor.Redact(msg), fields...)
+}
+
+func logInfo(ctx context.Context, msg string, fields ...map[string]interface{}) {
+	if subsystem, ok := loggingSubsystem(ctx); ok {
+		tflog.SubsystemInfo(ctx, subsystem, logRedactor.Redact(msg), fields...)
+		return
+	}
+	tflog.Info(ctx, logRedactor.Redact(msg), fields...)
+}
+
+func logWarn(ctx context.Context, msg string, fields ...map[string]interface{}) {
+	if subsystem, ok := loggingSubsystem(ctx); ok {
+		tflog.SubsystemWarn(ctx, subsystem, logRedactor.Redact(msg), fields...)
+		return
+	}
+	tflog.Warn(ctx, logRedactor.Redact(msg), fields...)
+}
+
+// apiLog logs an API request or response to the heroku_api subsystem. Calls
+// made without a resource's context, such as with context.TODO(), have no
+// tflog logger, so are logged through the standard library log package.
+func apiLog(ctx context.Context, level string, msg string, fields map[string]interface{}) {
+	if _, ok := loggingSubsystem(ctx); !ok {
+		keys := make([]string, 0, len(fields))
+		for k := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var b strings.Builder
+		for _, k := range keys {
+			fmt.Fprintf(&b, " %s=%v", k, fields[k])
+		}
+		log.Printf("[%s] %s: %s:%s", level, apiLogSubsystem, msg, b.String())
+		return
+	}
+
+	switch level {
+	case "WARN":
+		tflog.SubsystemWarn(ctx, apiLogSubsystem, msg, fields)
+	default:
+		tflog.SubsystemDebug(ctx, apiLogSubsystem, msg, fields)
+	}
+}
+
+// withResourceLogging wraps the context aware functions of resources and
+// data sources, to scope their logging to a subsystem named for their type.
+func withResourceLogging(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for name, r := range resources {
+		name := name
+
+		wrap := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			if f == nil {
+				return nil
+			}
+			return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				return f(resourceLoggingContext(ctx, name), d, meta)
+			}
+		}
+
+		r.CreateContext = wrap(r.CreateContext)
+		r.ReadContext = wrap(r.ReadContext)
+		r.UpdateContext = wrap(r.UpdateContext)
+		r.DeleteContext = wrap(r.DeleteContext)
+		r.CreateWithoutTimeout = wrap(r.CreateWithoutTimeout)
+
+		if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+			r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				return customizeDiff(resourceLoggingContext(ctx, name), d, meta)
+			}
+		}
+
+		if r.Importer != nil && r.Importer.StateContext != nil {
+			importState := r.Importer.StateContext
+			r.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return importState(resourceLoggingContext(ctx, name), d, meta)
+			}
+		}
+	}
+	return resources
+}
+
+// logKeys returns the sorted keys of m, to log config vars and other
+// sensitive maps without their values.
+func logKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,99 @@
+package heroku
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+)
+
+// loggingTransport logs each Heroku API request and response, with secrets
+// masked, to the heroku_api logging subsystem.
+type loggingTransport struct {
+	Base     http.RoundTripper
+	Redactor *redactor
+
+	// LogBodies logs request and response bodies as well.
+	LogBodies bool
+}
+
+func (t *loggingTransport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    t.Redactor.Redact(req.URL.String()),
+	}
+
+	if t.LogBodies {
+		fields["http_request_headers"] = t.redactHeaders(req.Header)
+		if req.GetBody != nil {
+			if body, err := req.GetBody(); err == nil {
+				raw, _ := io.ReadAll(body)
+				body.Close()
+				if len(raw) > 0 {
+					fields["http_request_body"] = t.Redactor.RedactBody(req.URL.Path, raw)
+				}
+			}
+		}
+	}
+
+	apiLog(ctx, "DEBUG", "Sending HTTP request", fields)
+
+	start := time.Now()
+	resp, err := t.base().RoundTrip(req)
+
+	fields = map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    t.Redactor.Redact(req.URL.String()),
+		"duration_ms": time.Since(start).Milliseconds(),
+	}
+
+	if err != nil {
+		fields["error"] = t.Redactor.Redact(err.Error())
+		apiLog(ctx, "WARN", "HTTP request failed", fields)
+		return resp, err
+	}
+
+	fields["http_status_code"] = resp.StatusCode
+	if requestID := resp.Header.Get("Request-Id"); requestID != "" {
+		fields["request_id"] = requestID
+	}
+
+	if t.LogBodies {
+		fields["http_response_headers"] = t.redactHeaders(resp.Header)
+		if resp.Body != nil {
+			raw, readErr := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			resp.Body = io.NopCloser(bytes.NewReader(raw))
+			if readErr == nil && len(raw) > 0 {
+				fields["http_response_body"] = t.Redactor.RedactBody(req.URL.Path, raw)
+			}
+		}
+	}
+
+	apiLog(ctx, "DEBUG", "Received HTTP response", fields)
+
+	return resp, nil
+}
+
+// redactHeaders flattens headers for logging, masking credentials.
+func (t *loggingTransport) redactHeaders(header http.Header) map[string]string {
+	redacted := make(map[string]string, len(header))
+	for k := range header {
+		switch http.CanonicalHeaderKey(k) {
+		case "Authorization", "Cookie", "Set-Cookie":
+			redacted[k] = redactedValue
+		default:
+			redacted[k] = t.Redactor.Redact(header.Get(k))
+		}
+	}
+	return redacted
+}
@@ -36,6 +36,36 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("HEROKU_API_URL", heroku.DefaultURL),
 			},
 
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("HEROKU_PROXY_URL", ""),
+			},
+
+			"ca_bundle_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("HEROKU_CA_BUNDLE_FILE", ""),
+			},
+
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("HEROKU_INSECURE_SKIP_VERIFY", false),
+			},
+
+			"client_certificate_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("HEROKU_CLIENT_CERTIFICATE_FILE", ""),
+			},
+
+			"client_key_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("HEROKU_CLIENT_KEY_FILE", ""),
+			},
+
 			"customizations": {
 				Type:     schema.TypeList,
 				MaxItems: 2,
@@ -99,27 +129,127 @@ func Provider() *schema.Provider {
 					},
 				},
 			},
+
+			"retry": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"initial_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      DefaultRetryInitialInterval,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"multiplier": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Default:      DefaultRetryMultiplier,
+							ValidateFunc: validation.FloatAtLeast(1),
+						},
+						"max_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      DefaultRetryMaxInterval,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_elapsed_time": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      DefaultRetryMaxElapsedTime,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"retryable_status_codes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(400, 599),
+							},
+						},
+					},
+				},
+			},
+
+			"rate_limit": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Default:      DefaultRateLimitRequestsPerSecond,
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"defaults": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"team": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"space": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"generation": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
 		},
 
-		ResourcesMap: map[string]*schema.Resource{
+		ResourcesMap: withResourceLogging(map[string]*schema.Resource{
+			"heroku_account":                           resourceHerokuAccount(),
 			"heroku_account_feature":                   resourceHerokuAccountFeature(),
 			"heroku_addon":                             resourceHerokuAddon(),
 			"heroku_addon_attachment":                  resourceHerokuAddonAttachment(),
+			"heroku_addon_webhook":                     resourceHerokuAddonWebhook(),
 			"heroku_app":                               resourceHerokuApp(),
 			"heroku_app_config_association":            resourceHerokuAppConfigAssociation(),
 			"heroku_app_feature":                       resourceHerokuAppFeature(),
 			"heroku_app_release":                       resourceHerokuAppRelease(),
+			"heroku_app_setup":                         resourceHerokuAppSetup(),
+			"heroku_app_transfer":                      resourceHerokuAppTransfer(),
+			"heroku_app_transfer_acceptance":           resourceHerokuAppTransferAcceptance(),
 			"heroku_app_webhook":                       resourceHerokuAppWebhook(),
 			"heroku_build":                             resourceHerokuBuild(),
+			"heroku_buildpack_installation":            resourceHerokuBuildpackInstallation(),
 			"heroku_collaborator":                      resourceHerokuCollaborator(),
 			"heroku_config":                            resourceHerokuConfig(),
 			"heroku_domain":                            resourceHerokuDomain(),
 			"heroku_drain":                             resourceHerokuDrain(),
+			"heroku_enterprise_account_member":         resourceHerokuEnterpriseAccountMember(),
+			"heroku_enterprise_account_members":        resourceHerokuEnterpriseAccountMembers(),
 			"heroku_formation":                         resourceHerokuFormation(),
+			"heroku_formation_batch":                   resourceHerokuFormationBatch(),
+			"heroku_oauth_authorization":               resourceHerokuOAuthAuthorization(),
+			"heroku_oauth_client":                      resourceHerokuOAuthClient(),
 			"heroku_pipeline":                          resourceHerokuPipeline(),
 			"heroku_pipeline_config_var":               resourceHerokuPipelineConfigVar(),
 			"heroku_pipeline_coupling":                 resourceHerokuPipelineCoupling(),
 			"heroku_pipeline_promotion":                resourceHerokuPipelinePromotion(),
+			"heroku_review_app":                        resourceHerokuReviewApp(),
 			"heroku_review_app_config":                 resourceHerokuReviewAppConfig(),
 			"heroku_slug":                              resourceHerokuSlug(),
 			"heroku_space":                             resourceHerokuSpace(),
@@ -129,11 +259,17 @@ func Provider() *schema.Provider {
 			"heroku_space_vpn_connection":              resourceHerokuSpaceVPNConnection(),
 			"heroku_ssl":                               resourceHerokuSSL(),
 			"heroku_telemetry_drain":                   resourceHerokuTelemetryDrain(),
+			"heroku_team":                              resourceHerokuTeam(),
+			"heroku_team_allowed_addon_services":       resourceHerokuTeamAllowedAddonServices(),
 			"heroku_team_collaborator":                 resourceHerokuTeamCollaborator(),
+			"heroku_team_feature":                      resourceHerokuTeamFeature(),
+			"heroku_team_identity_provider":            resourceHerokuTeamIdentityProvider(),
+			"heroku_team_invitation":                   resourceHerokuTeamInvitation(),
 			"heroku_team_member":                       resourceHerokuTeamMember(),
-		},
+			"heroku_team_preferences":                  resourceHerokuTeamPreferences(),
+		}),
 
-		DataSourcesMap: map[string]*schema.Resource{
+		DataSourcesMap: withResourceLogging(map[string]*schema.Resource{
 			"heroku_addon":              dataSourceHerokuAddon(),
 			"heroku_app":                dataSourceHerokuApp(),
 			"heroku_pipeline":           dataSourceHerokuPipeline(),
@@ -141,7 +277,7 @@ func Provider() *schema.Provider {
 			"heroku_space_peering_info": dataSourceHerokuSpacePeeringInfo(),
 			"heroku_team":               dataSourceHerokuTeam(),
 			"heroku_team_members":       dataSourceHerokuTeamMembers(),
-		},
+		}),
 
 		ConfigureFunc: providerConfigure,
 	}
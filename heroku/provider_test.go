@@ -61,6 +61,44 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProvider_ContextAwareResources(t *testing.T) {
+	// Resources which poll Heroku, and so must have user configurable timeouts.
+	polling := []string{
+		"heroku_addon",
+		"heroku_app",
+		"heroku_app_config_association",
+		"heroku_app_release",
+		"heroku_build",
+		"heroku_domain",
+		"heroku_drain",
+		"heroku_slug",
+		"heroku_space",
+		"heroku_space_peering_connection_accepter",
+		"heroku_space_vpn_connection",
+	}
+
+	p := Provider()
+	for name, r := range p.ResourcesMap {
+		if r.Create != nil || r.Read != nil || r.Update != nil || r.Delete != nil {
+			t.Errorf("%s uses CRUD functions without a context", name)
+		}
+		if r.Importer != nil && r.Importer.State != nil {
+			t.Errorf("%s uses an importer without a context", name)
+		}
+	}
+	for name, r := range p.DataSourcesMap {
+		if r.Read != nil {
+			t.Errorf("data source %s uses a read function without a context", name)
+		}
+	}
+
+	for _, name := range polling {
+		if p.ResourcesMap[name].Timeouts == nil {
+			t.Errorf("%s polls, but has no timeouts", name)
+		}
+	}
+}
+
 func TestProviderConfigureUsesHeadersForClient(t *testing.T) {
 	p := Provider()
 	d := schema.TestResourceDataRaw(t, p.Schema, nil)
@@ -93,6 +131,7 @@ func TestProviderConfigureUsesHeadersForClient(t *testing.T) {
 }
 
 func testAccPreCheck(t *testing.T) {
+	testAccConfig.ConfigureFakeAPI(t)
 	testAccConfig.GetOrAbort(t, helper.TestConfigAPIKey)
 }
 
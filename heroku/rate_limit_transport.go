@@ -0,0 +1,166 @@
+package heroku
+
+import (
+	"context"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// herokuRateLimitReplenishRate is the rate at which Heroku refills an
+	// account's API budget: 4500 requests per hour.
+	// https://devcenter.heroku.com/articles/platform-api-reference#rate-limits
+	herokuRateLimitReplenishRate = float64(4500) / float64(3600)
+
+	// rateLimitSpendHorizon is the period over which the remaining API budget
+	// is spread once the limiter knows how much of it is left.
+	rateLimitSpendHorizon = 60 * time.Second
+)
+
+type skipRateLimitKey struct{}
+
+// rateLimiter is a token bucket shared by every request made through the
+// provider's API client. Its rate adapts to the account's remaining API
+// budget, as reported by Heroku's RateLimit-Remaining response header, so the
+// provider slows down before the budget runs out instead of stalling on 429s.
+type rateLimiter struct {
+	// target is the configured maximum requests per second.
+	// It is unlimited if target == 0.
+	target float64
+
+	// seed is called once, before the first request, to learn the remaining
+	// API budget.
+	seed     func(context.Context) error
+	seedOnce sync.Once
+
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+func newRateLimiter(target float64) *rateLimiter {
+	return &rateLimiter{
+		target: target,
+		rate:   target,
+		tokens: math.Max(1, target),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until the limiter permits a request, or ctx is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	l.seedOnce.Do(func() {
+		if l.seed == nil {
+			return
+		}
+		if err := l.seed(context.WithValue(ctx, skipRateLimitKey{}, true)); err != nil {
+			log.Printf("[WARN] Unable to retrieve Heroku API rate limit: %s", err)
+		}
+	})
+
+	for {
+		wait := l.reserve()
+		if wait == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a token and returns 0, or returns how long to wait before one
+// becomes available.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate == 0 {
+		return 0
+	}
+
+	now := time.Now()
+	l.tokens = math.Min(l.burst(), l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	if l.tokens >= 1 {
+		l.tokens--
+		return 0
+	}
+
+	return time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+}
+
+// Observe adjusts the limiter's rate to spend the remaining API budget evenly
+// over rateLimitSpendHorizon, never dropping below the replenish rate and
+// never exceeding the configured target.
+func (l *rateLimiter) Observe(remaining int) {
+	rate := herokuRateLimitReplenishRate + float64(remaining)/rateLimitSpendHorizon.Seconds()
+	if l.target > 0 {
+		rate = math.Min(rate, l.target)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate != rate {
+		log.Printf("[DEBUG] Heroku API rate limit remaining %d, limiting requests to %.2f/s", remaining, rate)
+	}
+	if l.rate == 0 {
+		l.tokens = 1
+		l.last = time.Now()
+	}
+	l.rate = rate
+	l.tokens = math.Min(l.burst(), l.tokens)
+}
+
+func (l *rateLimiter) burst() float64 {
+	return math.Max(1, l.rate)
+}
+
+// rateLimitTransport is a net/http RoundTripper that waits on a shared
+// rateLimiter before each request and feeds it the RateLimit-Remaining header
+// of each response.
+type rateLimitTransport struct {
+	// Base is the transport used to send each request.
+	// It will default to http.DefaultTransport if nil.
+	Base http.RoundTripper
+
+	Limiter *rateLimiter
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	if req.Context().Value(skipRateLimitKey{}) == nil {
+		if err := t.Limiter.Wait(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if v := resp.Header.Get("RateLimit-Remaining"); v != "" {
+		if remaining, convErr := strconv.Atoi(v); convErr == nil {
+			t.Limiter.Observe(remaining)
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,85 @@
+package heroku
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter_Observe(t *testing.T) {
+	testCases := []struct {
+		name      string
+		target    float64
+		remaining int
+		expected  float64
+	}{
+		{
+			name:      "Exhausted budget limits to the replenish rate",
+			target:    0,
+			remaining: 0,
+			expected:  herokuRateLimitReplenishRate,
+		},
+		{
+			name:      "Remaining budget is spread over the horizon",
+			target:    0,
+			remaining: 600,
+			expected:  herokuRateLimitReplenishRate + 10,
+		},
+		{
+			name:      "Target caps a plentiful budget",
+			target:    5,
+			remaining: 4500,
+			expected:  5,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := newRateLimiter(tc.target)
+			l.Observe(tc.remaining)
+
+			if l.rate != tc.expected {
+				t.Errorf("got rate %f, want %f", l.rate, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_WaitHonorsContext(t *testing.T) {
+	l := newRateLimiter(0)
+	l.Observe(0)
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestRateLimitTransport_ObservesRemainingHeader(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("RateLimit-Remaining", "120")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	l := newRateLimiter(0)
+	client := &http.Client{Transport: &rateLimitTransport{Limiter: l}}
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if expected := herokuRateLimitReplenishRate + 2; l.rate != expected {
+		t.Fatalf("got rate %f, want %f", l.rate, expected)
+	}
+}
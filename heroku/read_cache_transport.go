@@ -0,0 +1,172 @@
+package heroku
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"sync"
+)
+
+// readCachePaths are the lookups which are served from the read cache. These
+// are immutable or slowly changing resources, read repeatedly by the
+// resources which depend on them. Resources which are polled while they
+// change, such as add-ons by app and spaces, are deliberately absent.
+var readCachePaths = []*regexp.Regexp{
+	regexp.MustCompile(`^/apps/[^/]+$`),
+	regexp.MustCompile(`^/teams/apps/[^/]+$`),
+	regexp.MustCompile(`^/addons/[^/]+$`),
+	regexp.MustCompile(`^/generations(/[^/]+)?$`),
+	regexp.MustCompile(`^/teams/[^/]+$`),
+	regexp.MustCompile(`^/account$`),
+}
+
+// readCache holds successful responses to lookups for the lifetime of the
+// provider, which is a single Terraform run. Any write invalidates the whole
+// cache, as a write to one resource may change others, such as the config
+// vars an add-on sets on its app.
+type readCache struct {
+	mu      sync.Mutex
+	version uint64
+	entries map[string]*readCacheEntry
+}
+
+type readCacheEntry struct {
+	ready    chan struct{}
+	response *cachedResponse
+}
+
+type cachedResponse struct {
+	statusCode int
+	status     string
+	header     http.Header
+	body       []byte
+}
+
+func newReadCache() *readCache {
+	return &readCache{entries: make(map[string]*readCacheEntry)}
+}
+
+// Invalidate drops every cached response.
+func (c *readCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version++
+	c.entries = make(map[string]*readCacheEntry)
+}
+
+// readCacheTransport serves repeated lookups from a readCache, and
+// invalidates it on every write.
+type readCacheTransport struct {
+	Base  http.RoundTripper
+	Cache *readCache
+}
+
+func (t *readCacheTransport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+func (t *readCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Cache == nil {
+		return t.base().RoundTrip(req)
+	}
+
+	if !isCacheableRead(req) {
+		if req.Method != http.MethodGet && req.Method != http.MethodHead {
+			// Invalidate before and after, so that reads racing the write
+			// are not cached either.
+			t.Cache.Invalidate()
+			defer t.Cache.Invalidate()
+		}
+		return t.base().RoundTrip(req)
+	}
+
+	key := req.URL.String() + " " + req.Header.Get("Accept") + " " + req.Header.Get("Range")
+
+	for {
+		t.Cache.mu.Lock()
+		entry, ok := t.Cache.entries[key]
+		if !ok {
+			break
+		}
+		t.Cache.mu.Unlock()
+
+		// Wait for an identical lookup in flight, then use its response.
+		select {
+		case <-entry.ready:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		if entry.response != nil {
+			log.Printf("[DEBUG] Serving %s %s from the read cache", req.Method, req.URL.Path)
+			return entry.response.toResponse(req), nil
+		}
+	}
+
+	entry := &readCacheEntry{ready: make(chan struct{})}
+	version := t.Cache.version
+	t.Cache.entries[key] = entry
+	t.Cache.mu.Unlock()
+
+	resp, err := t.base().RoundTrip(req)
+
+	var cached *cachedResponse
+	if err == nil && resp.StatusCode == http.StatusOK {
+		body, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if readErr != nil {
+			err = readErr
+			resp = nil
+		} else {
+			cached = &cachedResponse{
+				statusCode: resp.StatusCode,
+				status:     resp.Status,
+				header:     resp.Header.Clone(),
+				body:       body,
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+		}
+	}
+
+	t.Cache.mu.Lock()
+	if cached != nil && t.Cache.version == version {
+		entry.response = cached
+	} else if t.Cache.entries[key] == entry {
+		delete(t.Cache.entries, key)
+	}
+	t.Cache.mu.Unlock()
+	close(entry.ready)
+
+	return resp, err
+}
+
+func isCacheableRead(req *http.Request) bool {
+	if req.Method != http.MethodGet {
+		return false
+	}
+	for _, path := range readCachePaths {
+		if path.MatchString(req.URL.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *cachedResponse) toResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        c.status,
+		StatusCode:    c.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,129 @@
+package heroku
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func newReadCacheTestServer(t *testing.T, status int) (*httptest.Server, *int32) {
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			atomic.AddInt32(&hits, 1)
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(`{"name":"some-app"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &hits
+}
+
+func readCacheGet(t *testing.T, client *http.Client, url string) string {
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestReadCacheTransport_CachesLookupsUntilWrite(t *testing.T) {
+	srv, hits := newReadCacheTestServer(t, http.StatusOK)
+	client := &http.Client{Transport: &readCacheTransport{Cache: newReadCache()}}
+
+	for i := 0; i < 3; i++ {
+		if body := readCacheGet(t, client, srv.URL+"/apps/some-app"); body != `{"name":"some-app"}` {
+			t.Fatalf("got body %q", body)
+		}
+	}
+	if *hits != 1 {
+		t.Fatalf("got %d requests, want 1", *hits)
+	}
+
+	req, _ := http.NewRequest(http.MethodPatch, srv.URL+"/apps/some-app/config-vars", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	readCacheGet(t, client, srv.URL+"/apps/some-app")
+	if *hits != 2 {
+		t.Fatalf("got %d requests after a write, want 2", *hits)
+	}
+}
+
+func TestReadCacheTransport_SkipsUncacheableReads(t *testing.T) {
+	testCases := []struct {
+		name   string
+		status int
+		path   string
+	}{
+		{
+			name:   "Polled resources are not cached",
+			status: http.StatusOK,
+			path:   "/apps/some-app/addons/some-addon",
+		},
+		{
+			name:   "Errors are not cached",
+			status: http.StatusNotFound,
+			path:   "/apps/some-app",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv, hits := newReadCacheTestServer(t, tc.status)
+			client := &http.Client{Transport: &readCacheTransport{Cache: newReadCache()}}
+
+			readCacheGet(t, client, srv.URL+tc.path)
+			readCacheGet(t, client, srv.URL+tc.path)
+
+			if *hits != 2 {
+				t.Fatalf("got %d requests, want 2", *hits)
+			}
+		})
+	}
+}
+
+func TestReadCacheTransport_SharesConcurrentLookups(t *testing.T) {
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	var hits int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		started <- struct{}{}
+		<-release
+		w.Write([]byte(`{"name":"some-app"}`))
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &readCacheTransport{Cache: newReadCache()}}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			readCacheGet(t, client, srv.URL+"/apps/some-app")
+		}()
+	}
+
+	// Hold the first lookup in flight while the others queue up behind it.
+	<-started
+	close(release)
+	wg.Wait()
+
+	if hits != 1 {
+		t.Fatalf("got %d requests, want 1", hits)
+	}
+}
@@ -0,0 +1,244 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+// resourceHerokuAccount manages the settings of the authenticated account. The
+// account always exists, so creating the resource adopts it, and destroying
+// the resource leaves the account as it is.
+func resourceHerokuAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuAccountCreate,
+		ReadContext:   resourceHerokuAccountRead,
+		UpdateContext: resourceHerokuAccountUpdate,
+		DeleteContext: resourceHerokuAccountDelete,
+
+		CustomizeDiff: resourceHerokuAccountCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHerokuAccountImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"allow_tracking": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"beta": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"default_team": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"default_organization"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"default_organization": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"default_team"},
+				ValidateFunc:  validation.StringIsNotEmpty,
+			},
+
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"two_factor_authentication": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"verified": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"federated": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// default_team and default_organization are the same team, so a change to one
+// is planned for the other.
+func resourceHerokuAccountCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	for _, keys := range [][2]string{{"default_team", "default_organization"}, {"default_organization", "default_team"}} {
+		if diff.HasChange(keys[0]) && !isUnsetInConfig(diff, keys[0]) {
+			if !diff.NewValueKnown(keys[0]) {
+				return diff.SetNewComputed(keys[1])
+			}
+			return diff.SetNew(keys[1], diff.Get(keys[0]))
+		}
+	}
+	return nil
+}
+
+// The account can be imported by its ID or email, which must be those of the
+// authenticated account.
+func resourceHerokuAccountImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	account, err := getAccount(ctx, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.Id() != account.ID && !strings.EqualFold(d.Id(), account.Email) {
+		return nil, fmt.Errorf("Only the authenticated account (%s) can be imported, not %s", account.Email, d.Id())
+	}
+
+	d.SetId(account.ID)
+	setAccountAttributes(d, account)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceHerokuAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	account, err := getAccount(ctx, meta)
+	if err != nil {
+		return diag.Errorf("Error retrieving account: %s", err)
+	}
+
+	d.SetId(account.ID)
+	log.Printf("[INFO] Managing account %s", account.Email)
+
+	if err := updateAccount(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceHerokuAccountRead(ctx, d, meta)
+}
+
+func resourceHerokuAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	account, err := getAccount(ctx, meta)
+	if err != nil {
+		return diag.Errorf("Error retrieving account: %s", err)
+	}
+
+	if account.ID != d.Id() {
+		return diag.Errorf("The provider is authenticated as %s (%s), but this resource manages account %s. Authenticate as that account, or remove the resource from state.", account.Email, account.ID, d.Id())
+	}
+
+	setAccountAttributes(d, account)
+
+	return nil
+}
+
+func setAccountAttributes(d *schema.ResourceData, account *heroku.Account) {
+	d.Set("email", account.Email)
+	d.Set("allow_tracking", account.AllowTracking)
+	d.Set("beta", account.Beta)
+	d.Set("two_factor_authentication", account.TwoFactorAuthentication)
+	d.Set("verified", account.Verified)
+	d.Set("federated", account.Federated)
+
+	if account.Name != nil {
+		d.Set("name", *account.Name)
+	} else {
+		d.Set("name", "")
+	}
+
+	// default_organization is the former name of default_team, so both are
+	// the same team.
+	defaultTeam := ""
+	if account.DefaultTeam != nil {
+		defaultTeam = account.DefaultTeam.Name
+	} else if account.DefaultOrganization != nil {
+		defaultTeam = account.DefaultOrganization.Name
+	}
+	d.Set("default_team", defaultTeam)
+	d.Set("default_organization", defaultTeam)
+}
+
+func resourceHerokuAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := updateAccount(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceHerokuAccountRead(ctx, d, meta)
+}
+
+// Accounts cannot be deleted through Terraform, so the account is left as it
+// is.
+func resourceHerokuAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Removing account %s from state. The account and its settings are left as they are.", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// updateAccount applies the configured settings which differ from the
+// account's. Settings which are not configured keep their current values.
+func updateAccount(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Config).Api
+
+	changed := func(key string) bool {
+		return isSetInRawConfig(d, key) && (d.IsNewResource() || d.HasChange(key))
+	}
+
+	opts := heroku.AccountUpdateOpts{}
+	update := false
+	if changed("name") {
+		name := d.Get("name").(string)
+		opts.Name = &name
+		update = true
+	}
+	if changed("allow_tracking") {
+		allowTracking := d.Get("allow_tracking").(bool)
+		opts.AllowTracking = &allowTracking
+		update = true
+	}
+	if changed("beta") {
+		beta := d.Get("beta").(bool)
+		opts.Beta = &beta
+		update = true
+	}
+
+	if update {
+		log.Printf("[DEBUG] Updating account %s", d.Id())
+		if _, err := client.AccountUpdate(ctx, opts); err != nil {
+			return fmt.Errorf("Error updating account: %s", err)
+		}
+	}
+
+	// The default team is a setting of the team, rather than the account.
+	for _, key := range []string{"default_team", "default_organization"} {
+		if !changed(key) {
+			continue
+		}
+
+		team := d.Get(key).(string)
+		isDefault := true
+		log.Printf("[DEBUG] Setting the default team of account %s to %s", d.Id(), team)
+		if _, err := client.TeamUpdate(ctx, team, heroku.TeamUpdateOpts{Default: &isDefault}); err != nil {
+			return fmt.Errorf("Error setting team %s as the default: %s", team, err)
+		}
+	}
+
+	return nil
+}
@@ -5,19 +5,20 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	heroku "github.com/heroku/heroku-go/v6"
 )
 
 func resourceHerokuAccountFeature() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuAccountFeatureUpdate,
-		Read:   resourceHerokuAccountFeatureRead,
-		Update: resourceHerokuAccountFeatureUpdate,
-		Delete: resourceHerokuAccountFeatureDelete,
+		CreateContext: resourceHerokuAccountFeatureUpdate,
+		ReadContext:   resourceHerokuAccountFeatureRead,
+		UpdateContext: resourceHerokuAccountFeatureUpdate,
+		DeleteContext: resourceHerokuAccountFeatureDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuAccountFeatureImport,
+			StateContext: resourceHerokuAccountFeatureImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -45,7 +46,7 @@ func resourceHerokuAccountFeature() *schema.Resource {
 	}
 }
 
-func resourceHerokuAccountFeatureImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuAccountFeatureImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	_, accountFeatureName, err := parseCompositeID(d.Id())
 	if err != nil {
 		return nil, err
@@ -53,7 +54,7 @@ func resourceHerokuAccountFeatureImport(d *schema.ResourceData, meta interface{}
 	d.SetId(d.Id())
 	d.Set("name", accountFeatureName)
 
-	readErr := resourceHerokuAccountFeatureRead(d, meta)
+	readErr := diagnosticsError(resourceHerokuAccountFeatureRead(ctx, d, meta))
 	if readErr != nil {
 		return nil, readErr
 	}
@@ -63,37 +64,37 @@ func resourceHerokuAccountFeatureImport(d *schema.ResourceData, meta interface{}
 
 // Account Feature endpoint has no CREATE endpoint
 // so UPDATE will serve both create/update functionality for this resource.
-func resourceHerokuAccountFeatureUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAccountFeatureUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var enabled bool
 	if v, ok := d.GetOk("enabled"); ok {
 		enabled = v.(bool)
 	}
 
-	accountFeature, err := updateAccountFeature(enabled, d, meta)
+	accountFeature, err := updateAccountFeature(ctx, enabled, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	// Get Account email. We will use a combo of account email + feature UUID as the resource id
-	account, err := getAccount(meta)
+	account, err := getAccount(ctx, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	accountEmail := account.Email
 
 	d.SetId(buildCompositeID(accountEmail, accountFeature.Name))
 
-	return resourceHerokuAccountFeatureRead(d, meta)
+	return resourceHerokuAccountFeatureRead(ctx, d, meta)
 }
 
-func resourceHerokuAccountFeatureRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAccountFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	featureName := getAccountFeatureName(d)
 
-	accountFeature, err := client.AccountFeatureInfo(context.TODO(), featureName)
+	accountFeature, err := client.AccountFeatureInfo(ctx, featureName)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("name", accountFeature.Name)
@@ -106,17 +107,17 @@ func resourceHerokuAccountFeatureRead(d *schema.ResourceData, meta interface{})
 
 // There is no account feature DELETE endpoint. Behavior will be to set feature to enabled = false
 // and remove resource from state.
-func resourceHerokuAccountFeatureDelete(d *schema.ResourceData, meta interface{}) error {
-	_, err := updateAccountFeature(false, d, meta)
+func resourceHerokuAccountFeatureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, err := updateAccountFeature(ctx, false, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
 // utility method to update heroku account feature
-func updateAccountFeature(enabled bool, d *schema.ResourceData, meta interface{}) (*heroku.AccountFeature, error) {
+func updateAccountFeature(ctx context.Context, enabled bool, d *schema.ResourceData, meta interface{}) (*heroku.AccountFeature, error) {
 	client := meta.(*Config).Api
 
 	featureName := getAccountFeatureName(d)
@@ -125,7 +126,7 @@ func updateAccountFeature(enabled bool, d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("[DEBUG] Updating Heroku Account Feature...")
-	accountFeature, err := client.AccountFeatureUpdate(context.TODO(), featureName, opts)
+	accountFeature, err := client.AccountFeatureUpdate(ctx, featureName, opts)
 	if err != nil {
 		return nil, fmt.Errorf("Error enabling/disabling feature: %s opts %+v", err, opts)
 	}
@@ -133,10 +134,10 @@ func updateAccountFeature(enabled bool, d *schema.ResourceData, meta interface{}
 	return accountFeature, nil
 }
 
-func getAccount(meta interface{}) (account *heroku.Account, err error) {
+func getAccount(ctx context.Context, meta interface{}) (account *heroku.Account, err error) {
 	client := meta.(*Config).Api
 
-	account, err = client.AccountInfo(context.TODO())
+	account, err = client.AccountInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,77 @@
+package heroku
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHerokuAccount_Basic(t *testing.T) {
+	email := testAccConfig.GetEmailOrSkip(t)
+	name := fmt.Sprintf("tftest %s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuAccountConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_account.foobar", "email", email),
+					resource.TestCheckResourceAttr(
+						"heroku_account.foobar", "name", name),
+					resource.TestCheckResourceAttr(
+						"heroku_account.foobar", "allow_tracking", "false"),
+					resource.TestCheckResourceAttrSet(
+						"heroku_account.foobar", "verified"),
+				),
+			},
+			{
+				Config: testAccCheckHerokuAccountConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_account.foobar", "allow_tracking", "true"),
+				),
+			},
+			{
+				ResourceName:      "heroku_account.foobar",
+				ImportStateId:     email,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccHerokuAccount_ImportOtherAccount(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:        `resource "heroku_account" "foobar" {}`,
+				ResourceName:  "heroku_account.foobar",
+				ImportStateId: "someone-else@example.com",
+				ImportState:   true,
+				ExpectError:   regexp.MustCompile(`Only the authenticated account .* can be imported`),
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuAccountConfig(name string, allowTracking bool) string {
+	return fmt.Sprintf(`
+resource "heroku_account" "foobar" {
+	name           = "%s"
+	allow_tracking = %t
+}
+`, name, allowTracking)
+}
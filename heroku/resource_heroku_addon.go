@@ -4,12 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/url"
 	"regexp"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -23,14 +23,16 @@ var addonLock sync.Mutex
 
 func resourceHerokuAddon() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuAddonCreate,
-		Read:   resourceHerokuAddonRead,
-		Update: resourceHerokuAddonUpdate,
-		Delete: resourceHerokuAddonDelete,
-		Exists: resourceHerokuAddonExists,
+		// Creation is bounded by addonCreateTimeout, rather than the SDK's
+		// create timeout, to honor the provider's addon_create_timeout.
+		CreateWithoutTimeout: resourceHerokuAddonCreate,
+		ReadContext:          resourceHerokuAddonRead,
+		UpdateContext:        resourceHerokuAddonUpdate,
+		DeleteContext:        resourceHerokuAddonDelete,
+		CustomizeDiff:        resourceHerokuAddonCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 3,
@@ -85,6 +87,10 @@ func resourceHerokuAddon() *schema.Resource {
 				Sensitive: true,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(time.Duration(DefaultAddonCreateTimeout) * time.Minute),
+		},
 	}
 }
 
@@ -107,18 +113,96 @@ func validateCustomAddonName(v interface{}, k string) (ws []string, errors []err
 	return ws, errors
 }
 
-func resourceHerokuAddonCreate(d *schema.ResourceData, meta interface{}) error {
+// resourceHerokuAddonCustomizeDiff warns when the plan's add-on service is not
+// allowed for the app's team. Lookups which fail are skipped, so that the
+// warning never blocks a plan.
+func resourceHerokuAddonCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.HasChange("plan") || !diff.NewValueKnown("plan") || !diff.NewValueKnown("app_id") {
+		return nil
+	}
+
+	client := v.(*Config).Api
+	appID := diff.Get("app_id").(string)
+	service := addonPlanService(diff.Get("plan").(string))
+
+	app, err := client.AppInfo(ctx, appID)
+	if err != nil {
+		log.Printf("[DEBUG] Skipping the allowed add-on services check, as app %s could not be retrieved: %s", appID, err)
+		return nil
+	}
+	if app.Team == nil {
+		return nil
+	}
+
+	// The allow-list is only applied when the team's add-on controls are on.
+	preferences, err := client.TeamPreferencesList(ctx, app.Team.Name)
+	if err != nil {
+		log.Printf("[DEBUG] Skipping the allowed add-on services check, as the preferences of team %s could not be retrieved: %s", app.Team.Name, err)
+		return nil
+	}
+	if preferences.AddonsControls != nil && !*preferences.AddonsControls {
+		return nil
+	}
+
+	allowed, err := listTeamAllowedAddonServices(ctx, client, app.Team.Name)
+	if err != nil {
+		log.Printf("[DEBUG] Skipping the allowed add-on services check: %s", err)
+		return nil
+	}
+
+	if !isAddonServiceAllowed(allowed, service) {
+		logWarn(ctx, fmt.Sprintf("Add-on service %q is not allowed for team %s, which owns app %s, so creating the add-on will fail", service, app.Team.Name, app.Name))
+	}
+
+	return nil
+}
+
+// addonPlanService returns the add-on service of a plan such as
+// "heroku-postgresql:essential-0".
+func addonPlanService(plan string) string {
+	return strings.SplitN(plan, ":", 2)[0]
+}
+
+// isAddonServiceAllowed reports whether service is on a team's allow-list. An
+// empty allow-list allows every service.
+func isAddonServiceAllowed(allowed heroku.AllowedAddOnServiceListByTeamResult, service string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, a := range allowed {
+		if a.AddonService.Name == service || a.AddonService.ID == service {
+			return true
+		}
+	}
+	return false
+}
+
+// addonCreateTimeout returns the resource's create timeout when its timeouts
+// block is configured, or else the provider's addon_create_timeout. Create is
+// the only timeout of add-ons, so the block always sets it.
+func addonCreateTimeout(d *schema.ResourceData, config *Config) time.Duration {
+	if isSetInRawConfig(d, "timeouts") {
+		return d.Timeout(schema.TimeoutCreate)
+	}
+	return time.Duration(config.AddonCreateTimeout) * time.Minute
+}
+
+func resourceHerokuAddonCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	addonLock.Lock()
 	defer addonLock.Unlock()
 
 	config := meta.(*Config)
 	client := config.Api
 
+	timeout := addonCreateTimeout(d, config)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	plan := d.Get("plan").(string)
 	appID := d.Get("app_id").(string)
-	app, err := client.AppInfo(context.TODO(), appID)
+	app, err := client.AppInfo(ctx, appID)
 	if err != nil {
-		return fmt.Errorf("Error reading app for addon: %w", err)
+		return diag.Errorf("Error reading app for addon: %s", err)
 	}
 
 	opts := heroku.AddOnCreateOpts{
@@ -137,10 +221,10 @@ func resourceHerokuAddonCreate(d *schema.ResourceData, meta interface{}) error {
 		opts.Name = &v
 	}
 
-	log.Printf("[DEBUG] Addon create configuration: %#v, %#v", appID, opts)
-	addon, err := client.AddOnCreate(context.TODO(), appID, opts)
+	log.Printf("[DEBUG] Addon create configuration: %s, plan %s, config %s", appID, opts.Plan, logKeys(opts.Config))
+	addon, err := client.AddOnCreate(ctx, appID, opts)
 	if err != nil {
-		return fmt.Errorf("Error creating addon %s for app %s (%s): %w", plan, app.Name, appID, err)
+		return diag.Errorf("Error creating addon %s for app %s (%s): %s", plan, app.Name, appID, err)
 	}
 
 	// Wait for the Addon to be provisioned
@@ -148,12 +232,12 @@ func resourceHerokuAddonCreate(d *schema.ResourceData, meta interface{}) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"provisioning"},
 		Target:  []string{"provisioned"},
-		Refresh: AddOnStateRefreshFunc(client, appID, addon.ID),
-		Timeout: time.Duration(config.AddonCreateTimeout) * time.Minute,
+		Refresh: AddOnStateRefreshFunc(ctx, client, appID, addon.ID),
+		Timeout: timeout,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Addon (%s) to be provisioned: %s", addon.ID, err)
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for Addon (%s) to be provisioned: %s", addon.ID, err)
 	}
 	log.Printf("[INFO] Addon provisioned: %s", addon.ID)
 
@@ -162,32 +246,37 @@ func resourceHerokuAddonCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Addon ID: %s", d.Id())
 
 	if config.SetAddonConfigVarsInState {
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			configVarValues, err := retrieveSpecificConfigVars(client, addon.App.ID, addon.ConfigVars)
+		err = resource.RetryContext(ctx, remainingTimeout(ctx, timeout), func() *resource.RetryError {
+			configVarValues, err := retrieveSpecificConfigVars(ctx, client, addon.App.ID, addon.ConfigVars)
 			if err != nil {
 				return resource.NonRetryableError(err)
 			}
 			if len(configVarValues) != len(addon.ConfigVars) {
 				return resource.RetryableError(fmt.Errorf("Got %d add-on config vars from the app, but expected %d", len(configVarValues), len(addon.ConfigVars)))
 			}
-			log.Printf("[INFO] Addon config vars are set: %v", addon.ConfigVars)
+			log.Printf("[INFO] Addon config vars are set: %s", addon.ConfigVars)
 			return nil
 		})
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceHerokuAddonRead(d, meta)
+	return resourceHerokuAddonRead(ctx, d, meta)
 }
 
-func resourceHerokuAddonRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAddonRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client := config.Api
 
-	addon, err := resourceHerokuAddonRetrieve(d.Id(), client)
+	addon, err := resourceHerokuAddonRetrieve(ctx, d.Id(), client)
 	if err != nil {
-		return err
+		if isNotFoundError(err) {
+			logWarn(ctx, fmt.Sprintf("Add-on %s no longer exists, removing it from state", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
 	// Determine the plan. If we were configured without a specific plan,
@@ -208,25 +297,25 @@ func resourceHerokuAddonRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("plan", plan)
 	d.Set("provider_id", addon.ProviderID)
 	if err := d.Set("config_vars", addon.ConfigVars); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("config_var_values", map[string]string{})
 	if config.SetAddonConfigVarsInState {
-		configVarValues, err := retrieveSpecificConfigVars(client, addon.App.ID, addon.ConfigVars)
+		configVarValues, err := retrieveSpecificConfigVars(ctx, client, addon.App.ID, addon.ConfigVars)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		err = d.Set("config_var_values", configVarValues)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceHerokuAddonUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAddonUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	opts := heroku.AddOnUpdateOpts{}
 
@@ -241,61 +330,47 @@ func resourceHerokuAddonUpdate(d *schema.ResourceData, meta interface{}) error {
 		opts.Name = &n
 	}
 
-	ad, updateErr := client.AddOnUpdate(context.TODO(), app, d.Id(), opts)
+	ad, updateErr := client.AddOnUpdate(ctx, app, d.Id(), opts)
 	if updateErr != nil {
-		return updateErr
+		return diag.FromErr(updateErr)
 	}
 
 	// Store the new addon id if applicable
 	d.SetId(ad.ID)
 
-	return resourceHerokuAddonRead(d, meta)
+	return resourceHerokuAddonRead(ctx, d, meta)
 }
 
-func resourceHerokuAddonDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAddonDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	log.Printf("[INFO] Deleting Addon: %s", d.Id())
 
 	// Destroy the app
-	_, err := client.AddOnDelete(context.TODO(), d.Get("app_id").(string), d.Id())
+	_, err := client.AddOnDelete(ctx, d.Get("app_id").(string), d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting addon: %s", err)
+		return diag.Errorf("Error deleting addon: %s", err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func resourceHerokuAddonExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	client := meta.(*Config).Api
-
-	_, err := client.AddOnInfo(context.TODO(), d.Id())
-	if err != nil {
-		if herr, ok := err.(*url.Error).Err.(heroku.Error); ok && herr.ID == "not_found" {
-			return false, nil
-		}
-		return false, err
-	}
-
-	return true, nil
-}
-
-func resourceHerokuAddonRetrieve(id string, client *heroku.Service) (*heroku.AddOn, error) {
-	addon, err := client.AddOnInfo(context.TODO(), id)
+func resourceHerokuAddonRetrieve(ctx context.Context, id string, client *heroku.Service) (*heroku.AddOn, error) {
+	addon, err := client.AddOnInfo(ctx, id)
 
 	if err != nil {
-		return nil, fmt.Errorf("Error retrieving addon: %s", err)
+		return nil, fmt.Errorf("Error retrieving addon: %w", err)
 	}
 
 	return addon, nil
 }
 
-func resourceHerokuAddonRetrieveByApp(app string, id string, client *heroku.Service) (*heroku.AddOn, error) {
-	addon, err := client.AddOnInfoByApp(context.TODO(), app, id)
+func resourceHerokuAddonRetrieveByApp(ctx context.Context, app string, id string, client *heroku.Service) (*heroku.AddOn, error) {
+	addon, err := client.AddOnInfoByApp(ctx, app, id)
 
 	if err != nil {
-		return nil, fmt.Errorf("Error retrieving addon: %s", err)
+		return nil, fmt.Errorf("Error retrieving addon: %w", err)
 	}
 
 	return addon, nil
@@ -303,9 +378,9 @@ func resourceHerokuAddonRetrieveByApp(app string, id string, client *heroku.Serv
 
 // AddOnStateRefreshFunc returns a resource.StateRefreshFunc that is used to
 // watch an AddOn.
-func AddOnStateRefreshFunc(client *heroku.Service, appID, addOnID string) resource.StateRefreshFunc {
+func AddOnStateRefreshFunc(ctx context.Context, client *heroku.Service, appID, addOnID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		addon, err := resourceHerokuAddonRetrieveByApp(appID, addOnID, client)
+		addon, err := resourceHerokuAddonRetrieveByApp(ctx, appID, addOnID, client)
 
 		if err != nil {
 			return nil, "", err
@@ -317,8 +392,8 @@ func AddOnStateRefreshFunc(client *heroku.Service, appID, addOnID string) resour
 	}
 }
 
-func retrieveSpecificConfigVars(client *heroku.Service, appID string, varNames []string) (map[string]string, error) {
-	vars, err := client.ConfigVarInfoForApp(context.TODO(), appID)
+func retrieveSpecificConfigVars(ctx context.Context, client *heroku.Service, appID string, varNames []string) (map[string]string, error) {
+	vars, err := client.ConfigVarInfoForApp(ctx, appID)
 	if err != nil {
 		return nil, err
 	}
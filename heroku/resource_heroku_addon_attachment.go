@@ -2,10 +2,10 @@ package heroku
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	heroku "github.com/heroku/heroku-go/v6"
@@ -13,12 +13,12 @@ import (
 
 func resourceHerokuAddonAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuAddonAttachmentCreate,
-		Read:   resourceHerokuAddonAttachmentRead,
-		Delete: resourceHerokuAddonAttachmentDelete,
+		CreateContext: resourceHerokuAddonAttachmentCreate,
+		ReadContext:   resourceHerokuAddonAttachmentRead,
+		DeleteContext: resourceHerokuAddonAttachmentDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		SchemaVersion: 1,
@@ -55,7 +55,7 @@ func resourceHerokuAddonAttachment() *schema.Resource {
 	}
 }
 
-func resourceHerokuAddonAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAddonAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	opts := heroku.AddOnAttachmentCreateOpts{Addon: d.Get("addon_id").(string), App: d.Get("app_id").(string)}
@@ -69,28 +69,28 @@ func resourceHerokuAddonAttachmentCreate(d *schema.ResourceData, meta interface{
 	}
 
 	log.Printf("[DEBUG] Addon Attachment create configuration: %#v", opts)
-	a, err := client.AddOnAttachmentCreate(context.TODO(), opts)
+	a, err := client.AddOnAttachmentCreate(ctx, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(a.ID)
 	log.Printf("[INFO] Addon Attachment ID: %s", d.Id())
 
-	return resourceHerokuAddonAttachmentRead(d, meta)
+	return resourceHerokuAddonAttachmentRead(ctx, d, meta)
 }
 
-func resourceHerokuAddonAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAddonAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	match, err := regexp.MatchString(`^[0-9a-f]+-[0-9a-f]+-[0-9a-f]+-[0-9a-f]+-[0-9a-f]+$`, d.Id())
 	if !match {
-		return fmt.Errorf("You can only import addon attachments by their unique ID")
+		return diag.Errorf("You can only import addon attachments by their unique ID")
 	}
 
-	addonattachment, err := client.AddOnAttachmentInfo(context.TODO(), d.Id())
+	addonattachment, err := client.AddOnAttachmentInfo(ctx, d.Id())
 	if err != nil {
-		return fmt.Errorf("Error retrieving addon attachment: %s", err)
+		return diag.Errorf("Error retrieving addon attachment: %s", err)
 	}
 
 	d.Set("app_id", addonattachment.App.ID)
@@ -101,15 +101,15 @@ func resourceHerokuAddonAttachmentRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceHerokuAddonAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAddonAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	log.Printf("[INFO] Deleting Addon Attachment: %s", d.Id())
 
 	// Destroy the app
-	_, err := client.AddOnAttachmentDelete(context.TODO(), d.Id())
+	_, err := client.AddOnAttachmentDelete(ctx, d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting addon attachment: %s", err)
+		return diag.Errorf("Error deleting addon attachment: %s", err)
 	}
 
 	d.SetId("")
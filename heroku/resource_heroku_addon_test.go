@@ -6,7 +6,9 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -208,6 +210,63 @@ func TestAccHerokuAddon_Disappears(t *testing.T) {
 	})
 }
 
+func TestIsAddonServiceAllowed(t *testing.T) {
+	var allowed heroku.AllowedAddOnServiceListByTeamResult
+	if !isAddonServiceAllowed(allowed, addonPlanService("heroku-postgresql:essential-0")) {
+		t.Fatal("an empty allow-list should allow every service")
+	}
+
+	allowed = append(allowed, heroku.AllowedAddOnService{})
+	allowed[0].AddonService.Name = "heroku-postgresql"
+	allowed[0].AddonService.ID = "01234567-89ab-cdef-0123-456789abcdef"
+
+	for _, plan := range []string{"heroku-postgresql", "heroku-postgresql:essential-0", "01234567-89ab-cdef-0123-456789abcdef"} {
+		if !isAddonServiceAllowed(allowed, addonPlanService(plan)) {
+			t.Fatalf("plan %q should be allowed", plan)
+		}
+	}
+
+	if isAddonServiceAllowed(allowed, addonPlanService("heroku-redis:mini")) {
+		t.Fatal("plan heroku-redis:mini should not be allowed")
+	}
+}
+
+func TestAddonCreateTimeout(t *testing.T) {
+	config := &Config{AddonCreateTimeout: 45}
+
+	testCases := []struct {
+		name     string
+		config   string
+		expected time.Duration
+	}{
+		{
+			name:     "Provider timeout",
+			config:   `{"app_id": "01234567-89ab-cdef-0123-456789abcdef", "plan": "heroku-postgresql:essential-0"}`,
+			expected: 45 * time.Minute,
+		},
+		{
+			name:     "Resource timeout set to the default",
+			config:   `{"app_id": "01234567-89ab-cdef-0123-456789abcdef", "plan": "heroku-postgresql:essential-0", "timeouts": {"create": "20m"}}`,
+			expected: 20 * time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := resourceHerokuAddon()
+			rawConfig, err := ctyjson.Unmarshal([]byte(tc.config), r.CoreConfigSchema().ImpliedType())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			d := r.Data(&terraform.InstanceState{RawConfig: rawConfig})
+			if actual := addonCreateTimeout(d, config); actual != tc.expected {
+				t.Fatalf("got %s, want %s", actual, tc.expected)
+			}
+		})
+	}
+}
+
 func testAccCheckHerokuAddonDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*Config)
 
@@ -0,0 +1,200 @@
+package heroku
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+func resourceHerokuAddonWebhook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuAddonWebhookCreate,
+		ReadContext:   resourceHerokuAddonWebhookRead,
+		UpdateContext: resourceHerokuAddonWebhookUpdate,
+		DeleteContext: resourceHerokuAddonWebhookDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHerokuAddonWebhookImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"addon_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"level": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"notify", "sync"}, true),
+			},
+
+			"url": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"include": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(webhookIncludes, true),
+				},
+			},
+
+			"secret": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+
+			"authorization": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+// Callback for schema Resource.Create
+func resourceHerokuAddonWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	addonID := d.Get("addon_id").(string)
+
+	opts := heroku.AddOnWebhookCreateOpts{
+		Level:   d.Get("level").(string),
+		URL:     d.Get("url").(string),
+		Include: getInclude(d),
+	}
+
+	if v, ok := d.GetOk("secret"); ok {
+		secret := v.(string)
+		opts.Secret = &secret
+	}
+
+	if v, ok := d.GetOk("authorization"); ok {
+		authorization := v.(string)
+		opts.Authorization = &authorization
+	}
+
+	webhook, err := client.AddOnWebhookCreate(ctx, addonID, opts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(webhook.ID)
+
+	return resourceHerokuAddonWebhookRead(ctx, d, meta)
+}
+
+// Callback for schema Resource.Read
+func resourceHerokuAddonWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	webhook, err := client.AddOnWebhookInfo(ctx, d.Get("addon_id").(string), d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("addon_id", webhook.Addon.ID)
+	d.Set("url", webhook.URL)
+	d.Set("level", webhook.Level)
+	d.Set("include", webhook.Include)
+
+	return nil
+}
+
+// Callback for schema Resource.Update
+func resourceHerokuAddonWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	opts := heroku.AddOnWebhookUpdateOpts{}
+
+	addonID := d.Get("addon_id").(string)
+
+	if d.HasChange("level") {
+		v := d.Get("level").(string)
+		log.Printf("[DEBUG] New Level: %s", v)
+		opts.Level = &v
+	}
+
+	if d.HasChange("url") {
+		v := d.Get("url").(string)
+		log.Printf("[DEBUG] New URL: %v", v)
+		opts.URL = &v
+	}
+
+	if d.HasChange("include") {
+		v := getIncludeAsPointers(d)
+		log.Printf("[DEBUG] New include: %v", v)
+		opts.Include = v
+	}
+
+	if d.HasChange("secret") {
+		if v, ok := d.GetOk("secret"); ok {
+			secret := v.(string)
+			log.Printf("[DEBUG] New Secret set")
+			opts.Secret = &secret
+		} else {
+			log.Printf("[DEBUG] Secret Removed")
+			opts.Secret = nil
+		}
+	}
+
+	if d.HasChange("authorization") {
+		if v, ok := d.GetOk("authorization"); ok {
+			authorization := v.(string)
+			log.Printf("[DEBUG] New Authorization set")
+			opts.Authorization = &authorization
+		} else {
+			log.Printf("[DEBUG] Authorization Removed")
+			opts.Authorization = nil
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Heroku add-on webhook...")
+	if _, err := client.AddOnWebhookUpdate(ctx, addonID, d.Id(), opts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceHerokuAddonWebhookRead(ctx, d, meta)
+}
+
+// Callback for schema Resource.Delete
+func resourceHerokuAddonWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	_, err := client.AddOnWebhookDelete(ctx, d.Get("addon_id").(string), d.Id())
+	return diag.FromErr(err)
+}
+
+func resourceHerokuAddonWebhookImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Config).Api
+
+	addon, id, err := parseCompositeID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := client.AddOnWebhookInfo(ctx, addon, id)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(webhook.ID)
+	d.Set("addon_id", webhook.Addon.ID)
+	d.Set("url", webhook.URL)
+	d.Set("level", webhook.Level)
+	d.Set("include", webhook.Include)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,139 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+func TestAccHerokuAddonWebhook_Basic(t *testing.T) {
+	var webhook heroku.AddOnWebhookInfoResult
+	addonID := testAccConfig.GetAddonIDOrSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHerokuAddonWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuAddonWebhookConfig(addonID, "https://terraform.example.com:1234", "notify", "api:release"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHerokuAddonWebhookExists("heroku_addon_webhook.foobar_webhook", &webhook),
+					testAccCheckHerokuAddonWebhookAttributes(&webhook, "https://terraform.example.com:1234", "notify", "api:release"),
+					resource.TestCheckResourceAttr("heroku_addon_webhook.foobar_webhook", "url", "https://terraform.example.com:1234"),
+					resource.TestCheckResourceAttr("heroku_addon_webhook.foobar_webhook", "level", "notify"),
+					resource.TestCheckResourceAttr("heroku_addon_webhook.foobar_webhook", "include.0", "api:release"),
+				),
+			},
+			{
+				Config: testAccCheckHerokuAddonWebhookConfig(addonID, "https://terraform.example.com:4321", "sync", "api:build"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHerokuAddonWebhookExists("heroku_addon_webhook.foobar_webhook", &webhook),
+					testAccCheckHerokuAddonWebhookAttributes(&webhook, "https://terraform.example.com:4321", "sync", "api:build"),
+					resource.TestCheckResourceAttr("heroku_addon_webhook.foobar_webhook", "url", "https://terraform.example.com:4321"),
+					resource.TestCheckResourceAttr("heroku_addon_webhook.foobar_webhook", "level", "sync"),
+					resource.TestCheckResourceAttr("heroku_addon_webhook.foobar_webhook", "include.0", "api:build"),
+				),
+			},
+			{
+				ResourceName:            "heroku_addon_webhook.foobar_webhook",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccHerokuAddonWebhookImportStateID("heroku_addon_webhook.foobar_webhook"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret", "authorization"},
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuAddonWebhookConfig(addonID, url, level, include string) string {
+	return fmt.Sprintf(`
+resource "heroku_addon_webhook" "foobar_webhook" {
+    addon_id = "%s"
+    url      = "%s"
+    level    = "%s"
+    include  = ["%s"]
+    secret   = "some-secret"
+}`, addonID, url, level, include)
+}
+
+func testAccHerokuAddonWebhookImportStateID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return buildCompositeID(rs.Primary.Attributes["addon_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckHerokuAddonWebhookDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).Api
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "heroku_addon_webhook" {
+			continue
+		}
+
+		_, err := client.AddOnWebhookInfo(context.TODO(), rs.Primary.Attributes["addon_id"], rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Webhook still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckHerokuAddonWebhookExists(n string, Webhook *heroku.AddOnWebhookInfoResult) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Webhook ID is set")
+		}
+
+		client := testAccProvider.Meta().(*Config).Api
+
+		foundWebhook, err := client.AddOnWebhookInfo(context.TODO(), rs.Primary.Attributes["addon_id"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if foundWebhook.ID != rs.Primary.ID {
+			return fmt.Errorf("Webhook not found")
+		}
+
+		*Webhook = *foundWebhook
+
+		return nil
+	}
+}
+
+func testAccCheckHerokuAddonWebhookAttributes(Webhook *heroku.AddOnWebhookInfoResult, url, level, include string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+
+		if Webhook.URL != url {
+			return fmt.Errorf("Bad URL: %s", Webhook.URL)
+		}
+
+		if Webhook.Level != level {
+			return fmt.Errorf("Bad Level: %s", Webhook.Level)
+		}
+
+		if len(Webhook.Include) != 1 || Webhook.Include[0] != include {
+			return fmt.Errorf("Bad Include: %v", Webhook.Include)
+		}
+
+		return nil
+	}
+}
@@ -5,11 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"log"
-	"net/url"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	multierror "github.com/hashicorp/go-multierror"
@@ -50,15 +50,14 @@ type application struct {
 
 func resourceHerokuApp() *schema.Resource {
 	return &schema.Resource{
-		Create:        switchHerokuAppCreate,
-		Read:          resourceHerokuAppRead,
-		Update:        resourceHerokuAppUpdate,
-		Delete:        resourceHerokuAppDelete,
-		Exists:        resourceHerokuAppExists,
+		CreateContext: switchHerokuAppCreate,
+		ReadContext:   resourceHerokuAppRead,
+		UpdateContext: resourceHerokuAppUpdate,
+		DeleteContext: resourceHerokuAppDelete,
 		CustomizeDiff: resourceHerokuAppCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuAppImport,
+			StateContext: resourceHerokuAppImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -70,12 +69,14 @@ func resourceHerokuApp() *schema.Resource {
 			"space": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
 			"region": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -159,6 +160,7 @@ func resourceHerokuApp() *schema.Resource {
 				MinItems: 0,
 				MaxItems: 1,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -197,13 +199,18 @@ func resourceHerokuApp() *schema.Resource {
 				Version: 0,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 	}
 }
 
-func resourceHerokuAppImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuAppImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	client := m.(*Config).Api
 
-	app, err := client.AppInfo(context.TODO(), d.Id())
+	app, err := client.AppInfo(ctx, d.Id())
 	if err != nil {
 		return nil, err
 	}
@@ -219,25 +226,27 @@ func resourceHerokuAppImport(d *schema.ResourceData, m interface{}) ([]*schema.R
 	// Punting this change for now.
 	d.SetId(app.ID)
 
-	readErr := resourceHerokuAppRead(d, m)
+	readErr := diagnosticsError(resourceHerokuAppRead(ctx, d, m))
 
 	return []*schema.ResourceData{d}, readErr
 }
 
-func switchHerokuAppCreate(d *schema.ResourceData, meta interface{}) (err error) {
+func switchHerokuAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	if isTeamApp(d) {
-		err = resourceHerokuTeamAppCreate(d, meta)
+		diags = resourceHerokuTeamAppCreate(ctx, d, meta)
 	} else {
-		err = resourceHerokuAppCreate(d, meta)
+		diags = resourceHerokuAppCreate(ctx, d, meta)
 	}
-	if err == nil {
+	if !diags.HasError() {
 		config := meta.(*Config)
-		time.Sleep(time.Duration(config.PostAppCreateDelay) * time.Second)
+		waitForReadiness(ctx, fmt.Sprintf("app (%s)", d.Id()),
+			appReadyStateRefreshFunc(ctx, config.Api, d.Id()),
+			time.Duration(config.PostAppCreateDelay)*time.Second)
 	}
 	return
 }
 
-func resourceHerokuAppCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	// Build up our creation options
@@ -260,22 +269,28 @@ func resourceHerokuAppCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Creating Heroku app...")
-	a, err := client.AppCreate(context.TODO(), opts)
+	a, err := client.AppCreate(ctx, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(a.ID)
 	log.Printf("[INFO] App ID: %s", d.Id())
 
-	if err := performAppPostCreateTasks(d, client); err != nil {
-		return err
+	if err := performAppPostCreateTasks(ctx, d, client); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceHerokuAppRead(d, meta)
+	// New apps have no ID to claim their buildpacks with when planned.
+	var diags diag.Diagnostics
+	if _, ok := d.GetOk("buildpacks"); ok {
+		diags = claimBuildpacks(ctx, meta.(*Config), d.Id(), "heroku_app")
+	}
+
+	return append(diags, resourceHerokuAppRead(ctx, d, meta)...)
 }
 
-func resourceHerokuTeamAppCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuTeamAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	// Build up our creation options
@@ -283,7 +298,7 @@ func resourceHerokuTeamAppCreate(d *schema.ResourceData, meta interface{}) error
 
 	v := d.Get("organization").([]interface{})
 	if len(v) > 1 {
-		return fmt.Errorf("rrror Creating Heroku App: Only 1 Heroku Team (organization) is permitted")
+		return diag.Errorf("rrror Creating Heroku App: Only 1 Heroku Team (organization) is permitted")
 	}
 	newTeamApp := v[0].(map[string]interface{})
 
@@ -332,19 +347,25 @@ func resourceHerokuTeamAppCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Creating Heroku app...")
-	a, err := client.TeamAppCreate(context.TODO(), opts)
+	a, err := client.TeamAppCreate(ctx, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(a.ID)
 	log.Printf("[INFO] App ID: %s", d.Id())
 
-	if err := performAppPostCreateTasks(d, client); err != nil {
-		return err
+	if err := performAppPostCreateTasks(ctx, d, client); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// New apps have no ID to claim their buildpacks with when planned.
+	var diags diag.Diagnostics
+	if _, ok := d.GetOk("buildpacks"); ok {
+		diags = claimBuildpacks(ctx, meta.(*Config), d.Id(), "heroku_app")
 	}
 
-	return resourceHerokuAppRead(d, meta)
+	return append(diags, resourceHerokuAppRead(ctx, d, meta)...)
 }
 
 func setTeamDetails(d *schema.ResourceData, app *application) (err error) {
@@ -380,7 +401,7 @@ func setAppDetails(d *schema.ResourceData, app *application) (err error) {
 	return err
 }
 
-func resourceHerokuAppRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*Config)
 	client := config.Api
 
@@ -392,9 +413,14 @@ func resourceHerokuAppRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Only set the config_vars that we have set in the configuration.
 	// The "all_config_vars" field has all of them.
-	app, err := resourceHerokuAppRetrieve(d.Id(), client)
+	app, err := resourceHerokuAppRetrieve(ctx, d.Id(), client)
 	if err != nil {
-		return err
+		if isNotFoundError(err) {
+			logWarn(ctx, fmt.Sprintf("App %s no longer exists, removing it from state", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
 	if c, ok := d.GetOk("config_vars"); ok {
@@ -421,7 +447,7 @@ func resourceHerokuAppRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	log.Printf("[DEBUG] Setting config vars: %s", configVars)
+	log.Printf("[DEBUG] Setting config vars: %s", logKeys(configVars))
 	if err := d.Set("config_vars", configVars); err != nil {
 		log.Printf("[WARN] Error setting config vars: %s", err)
 	}
@@ -446,7 +472,7 @@ func resourceHerokuAppRead(d *schema.ResourceData, meta interface{}) error {
 
 	buildpacksErr := d.Set("buildpacks", app.Buildpacks)
 	if buildpacksErr != nil {
-		return buildpacksErr
+		return diag.FromErr(buildpacksErr)
 	}
 
 	// Set generation based on app characteristics
@@ -455,20 +481,20 @@ func resourceHerokuAppRead(d *schema.ResourceData, meta interface{}) error {
 	if app.IsTeamApp {
 		orgErr := setTeamDetails(d, app)
 		if orgErr != nil {
-			return orgErr
+			return diag.FromErr(orgErr)
 		}
 	}
 
 	detailsErr := setAppDetails(d, app)
 	if detailsErr != nil {
-		return detailsErr
+		return diag.FromErr(detailsErr)
 	}
 
 	return nil
 }
 
 // resourceHerokuAppUpdate utilizes several unique API endpoints to update the app.
-func resourceHerokuAppUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	opts := heroku.AppUpdateOpts{}
 
@@ -482,17 +508,17 @@ func resourceHerokuAppUpdate(d *schema.ResourceData, meta interface{}) error {
 		opts.BuildStack = &v
 	}
 
-	updatedApp, err := client.AppUpdate(context.TODO(), d.Id(), opts)
+	updatedApp, err := client.AppUpdate(ctx, d.Id(), opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.Set("name", updatedApp.Name)
 
 	// Make changes (if any) to the app's buildpack.
 	if d.HasChange("buildpacks") {
-		err := updateBuildpacks(d.Id(), client, d.Get("buildpacks").([]interface{}))
+		err := updateBuildpacks(ctx, d.Id(), client, d.Get("buildpacks").([]interface{}))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -500,7 +526,7 @@ func resourceHerokuAppUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Check if there are overlapping config vars and error out as precaution
 	dupeErr := checkIfDupeConfigVars(d)
 	if dupeErr != nil {
-		return dupeErr
+		return diag.FromErr(dupeErr)
 	}
 
 	// If the config vars changed, then recalculate those
@@ -538,15 +564,15 @@ func resourceHerokuAppUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Merge the vars
 	allOldVars = combineVars(oldConfigVars, oldSensitiveConfigVars)
 	allNewVars = combineVars(newConfigVars, newSensitiveConfigVars)
-	if err := updateConfigVars(d.Id(), client, allOldVars, allNewVars); err != nil {
-		return err
+	if err := updateConfigVars(ctx, d.Id(), client, allOldVars, allNewVars); err != nil {
+		return diag.FromErr(err)
 	}
 
 	// Make changes (if any) to the app's ACM.
 	if d.HasChange("acm") {
-		err := updateAcm(d.Id(), client, d.Get("acm").(bool))
+		err := updateAcm(ctx, d.Id(), client, d.Get("acm").(bool))
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
@@ -561,63 +587,48 @@ func resourceHerokuAppUpdate(d *schema.ResourceData, meta interface{}) error {
 			log.Printf("[DEBUG] Organization updated locked: %t", vs)
 			teamAppUpdateOpts.Locked = vs
 
-			_, lockUpdateErr := client.TeamAppUpdateLocked(context.TODO(), d.Id(), teamAppUpdateOpts)
+			_, lockUpdateErr := client.TeamAppUpdateLocked(ctx, d.Id(), teamAppUpdateOpts)
 			if lockUpdateErr != nil {
-				return lockUpdateErr
+				return diag.FromErr(lockUpdateErr)
 			}
 		}
 	}
 
-	return resourceHerokuAppRead(d, meta)
-}
-
-func resourceHerokuAppDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*Config).Api
-
-	log.Printf("[INFO] Deleting App: %s", d.Id())
-	_, err := client.AppDelete(context.TODO(), d.Id())
-	if err != nil {
-		return fmt.Errorf("error deleting App: %s", err)
+	var diags diag.Diagnostics
+	if isSetInRawConfig(d, "buildpacks") {
+		diags = claimBuildpacks(ctx, meta.(*Config), d.Id(), "heroku_app")
 	}
 
-	return nil
+	return append(diags, resourceHerokuAppRead(ctx, d, meta)...)
 }
 
-func resourceHerokuAppExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	var err error
+func resourceHerokuAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
-	if isTeamApp(d) {
-		_, err = client.TeamAppInfo(context.TODO(), d.Id())
-	} else {
-		_, err = client.AppInfo(context.TODO(), d.Id())
-	}
+	log.Printf("[INFO] Deleting App: %s", d.Id())
+	_, err := client.AppDelete(ctx, d.Id())
 	if err != nil {
-		// Make sure it's a missing app error.
-		if herr, ok := err.(*url.Error).Err.(heroku.Error); ok && herr.ID == "not_found" {
-			return false, nil
-		}
-		return false, err
+		return diag.Errorf("error deleting App: %s", err)
 	}
 
-	return true, nil
+	return nil
 }
 
-func resourceHerokuAppRetrieve(id string, client *heroku.Service) (*application, error) {
+func resourceHerokuAppRetrieve(ctx context.Context, id string, client *heroku.Service) (*application, error) {
 	app := application{Id: id, Client: client, IsTeamApp: false}
 
-	err := app.Update()
+	err := app.Update(ctx)
 
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving app: %s", err)
+		return nil, fmt.Errorf("error retrieving app: %w", err)
 	}
 
 	return &app, nil
 }
 
 // Updates the application to have the latest from remote
-func (a *application) Update() error {
-	app, appGetErr := a.Client.AppInfo(context.TODO(), a.Id)
+func (a *application) Update(ctx context.Context) error {
+	app, appGetErr := a.Client.AppInfo(ctx, a.Id)
 	if appGetErr != nil {
 		return appGetErr
 	}
@@ -656,7 +667,7 @@ func (a *application) Update() error {
 
 		// Need to do another API call to the /teams/apps endpoint to retrieve
 		// additional info about a team app that isn't exposed through the /apps endpoint.
-		teamApp, teamAppGetErr := a.Client.TeamAppInfo(context.TODO(), a.Id)
+		teamApp, teamAppGetErr := a.Client.TeamAppInfo(ctx, a.Id)
 		if teamAppGetErr != nil {
 			return teamAppGetErr
 		}
@@ -670,7 +681,7 @@ func (a *application) Update() error {
 
 	// Only retrieve buildpacks for apps that support traditional buildpacks
 	if IsFeatureSupported(a.Generation, "app", "buildpacks") {
-		a.Buildpacks, err = retrieveBuildpacks(a.Id, a.Client)
+		a.Buildpacks, err = retrieveBuildpacks(ctx, a.Id, a.Client)
 		if err != nil {
 			errs = append(errs, err)
 		}
@@ -680,7 +691,7 @@ func (a *application) Update() error {
 		a.Buildpacks = []string{}
 	}
 
-	a.Vars, err = retrieveConfigVars(a.Id, a.Client)
+	a.Vars, err = retrieveConfigVars(ctx, a.Id, a.Client)
 	if err != nil {
 		errs = append(errs, err)
 	}
@@ -697,8 +708,8 @@ func isTeamApp(d *schema.ResourceData) bool {
 	return len(v) > 0 && v[0] != nil
 }
 
-func retrieveBuildpacks(id string, client *heroku.Service) ([]string, error) {
-	results, err := client.BuildpackInstallationList(context.TODO(), id, nil)
+func retrieveBuildpacks(ctx context.Context, id string, client *heroku.Service) ([]string, error) {
+	results, err := client.BuildpackInstallationList(ctx, id, nil)
 
 	if err != nil {
 		return nil, err
@@ -722,16 +733,16 @@ func isCNBError(err error) bool {
 		strings.Contains(errorMessage, "project.toml")
 }
 
-func retrieveAcm(id string, client *heroku.Service) (bool, error) {
-	result, err := client.AppInfo(context.TODO(), id)
+func retrieveAcm(ctx context.Context, id string, client *heroku.Service) (bool, error) {
+	result, err := client.AppInfo(ctx, id)
 	if err != nil {
 		return false, err
 	}
 	return result.Acm, nil
 }
 
-func retrieveConfigVars(id string, client *heroku.Service) (map[string]string, error) {
-	vars, err := client.ConfigVarInfoForApp(context.TODO(), id)
+func retrieveConfigVars(ctx context.Context, id string, client *heroku.Service) (map[string]string, error) {
+	vars, err := client.ConfigVarInfoForApp(ctx, id)
 
 	if err != nil {
 		return nil, err
@@ -748,7 +759,7 @@ func retrieveConfigVars(id string, client *heroku.Service) (map[string]string, e
 }
 
 // Updates the config vars for from an expanded configuration.
-func updateConfigVars(id string, client *heroku.Service, o, n map[string]interface{}) error {
+func updateConfigVars(ctx context.Context, id string, client *heroku.Service, o, n map[string]interface{}) error {
 	vars := make(map[string]*string)
 
 	for k := range o {
@@ -760,13 +771,13 @@ func updateConfigVars(id string, client *heroku.Service, o, n map[string]interfa
 		vars[k] = &val
 	}
 
-	log.Printf("[INFO] Updating config vars: *%#v", vars)
-	if _, err := client.ConfigVarUpdate(context.TODO(), id, vars); err != nil {
+	log.Printf("[INFO] Updating config vars: %s", logKeys(vars))
+	if _, err := client.ConfigVarUpdate(ctx, id, vars); err != nil {
 		return fmt.Errorf("Error updating config vars: %s", err)
 	}
 
 	releases, err := client.ReleaseList(
-		context.TODO(),
+		ctx,
 		id,
 		&heroku.ListRange{Descending: true, Field: "version", Max: 1},
 	)
@@ -781,18 +792,18 @@ func updateConfigVars(id string, client *heroku.Service, o, n map[string]interfa
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"succeeded"},
-		Refresh: releaseStateRefreshFunc(client, id, releases[0].ID),
-		Timeout: 20 * time.Minute,
+		Refresh: releaseStateRefreshFunc(ctx, client, id, releases[0].ID),
+		Timeout: remainingTimeout(ctx, 20*time.Minute),
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for new release (%s) to succeed: %s", releases[0].ID, err)
 	}
 
 	return nil
 }
 
-func updateBuildpacks(id string, client *heroku.Service, v []interface{}) error {
+func updateBuildpacks(ctx context.Context, id string, client *heroku.Service, v []interface{}) error {
 	opts := heroku.BuildpackInstallationUpdateOpts{
 		Updates: []struct {
 			Buildpack string `json:"buildpack" url:"buildpack,key"`
@@ -806,20 +817,20 @@ func updateBuildpacks(id string, client *heroku.Service, v []interface{}) error
 		})
 	}
 
-	if _, err := client.BuildpackInstallationUpdate(context.TODO(), id, opts); err != nil {
-		return fmt.Errorf("Error updating buildpacks: %s", err)
+	if _, err := client.BuildpackInstallationUpdate(ctx, id, opts); err != nil {
+		return fmt.Errorf("Error updating buildpacks: %w", err)
 	}
 
 	return nil
 }
 
-func updateAcm(id string, client *heroku.Service, enabled bool) error {
+func updateAcm(ctx context.Context, id string, client *heroku.Service, enabled bool) error {
 	if enabled {
-		if _, err := client.AppEnableACM(context.TODO(), id); err != nil {
+		if _, err := client.AppEnableACM(ctx, id); err != nil {
 			return err
 		}
 	} else {
-		if _, err := client.AppDisableACM(context.TODO(), id); err != nil {
+		if _, err := client.AppDisableACM(ctx, id); err != nil {
 			return err
 		}
 	}
@@ -841,7 +852,7 @@ func combineVars(configVars, sensitiveConfigVars map[string]interface{}) map[str
 }
 
 // performAppPostCreateTasks performs post-create tasks common to both org and non-org apps.
-func performAppPostCreateTasks(d *schema.ResourceData, client *heroku.Service) error {
+func performAppPostCreateTasks(ctx context.Context, d *schema.ResourceData, client *heroku.Service) error {
 	// Check if there are overlapping config vars and error out as precaution
 	dupeErr := checkIfDupeConfigVars(d)
 	if dupeErr != nil {
@@ -860,12 +871,12 @@ func performAppPostCreateTasks(d *schema.ResourceData, client *heroku.Service) e
 
 	allConfigVars = combineVars(configVars, sensitiveConfigVars)
 
-	if err := updateConfigVars(d.Id(), client, nil, allConfigVars); err != nil {
+	if err := updateConfigVars(ctx, d.Id(), client, nil, allConfigVars); err != nil {
 		return err
 	}
 
 	if v, ok := d.GetOk("buildpacks"); ok {
-		if err := updateBuildpacks(d.Id(), client, v.([]interface{})); err != nil {
+		if err := updateBuildpacks(ctx, d.Id(), client, v.([]interface{})); err != nil {
 			return err
 		}
 	}
@@ -874,7 +885,7 @@ func performAppPostCreateTasks(d *schema.ResourceData, client *heroku.Service) e
 		if _, ok := d.GetOk("organization"); !ok {
 			log.Printf("You ask me to enable ACM for a non-organization app. This will most likely fail, due to the Heroku constraints (the app has to be scaled to Standard-1X - state of 28.01.2018)")
 		}
-		if err := updateAcm(d.Id(), client, v.(bool)); err != nil {
+		if err := updateAcm(ctx, d.Id(), client, v.(bool)); err != nil {
 			return err
 		}
 	}
@@ -882,9 +893,9 @@ func performAppPostCreateTasks(d *schema.ResourceData, client *heroku.Service) e
 	return nil
 }
 
-func releaseStateRefreshFunc(client *heroku.Service, appID, releaseID string) resource.StateRefreshFunc {
+func releaseStateRefreshFunc(ctx context.Context, client *heroku.Service, appID, releaseID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		release, err := client.ReleaseInfo(context.TODO(), appID, releaseID)
+		release, err := client.ReleaseInfo(ctx, appID, releaseID)
 
 		if err != nil {
 			return nil, "", err
@@ -896,6 +907,25 @@ func releaseStateRefreshFunc(client *heroku.Service, appID, releaseID string) re
 	}
 }
 
+// appReadyStateRefreshFunc reports an app as ready once both the app and its
+// config vars can be read back from the API.
+func appReadyStateRefreshFunc(ctx context.Context, client *heroku.Service, appID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		app, err := client.AppInfo(ctx, appID)
+		if err != nil {
+			log.Printf("[DEBUG] App (%s) not yet readable: %s", appID, err)
+			return appID, "pending", nil
+		}
+
+		if _, err := client.ConfigVarInfoForApp(ctx, appID); err != nil {
+			log.Printf("[DEBUG] App (%s) config vars not yet readable: %s", appID, err)
+			return app, "pending", nil
+		}
+
+		return app, "ready", nil
+	}
+}
+
 func checkIfDupeConfigVars(d *schema.ResourceData) error {
 	log.Printf("[INFO] Checking for duplicate config vars")
 
@@ -937,12 +967,14 @@ func resourceHerokuAppV0() *schema.Resource {
 			"space": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
 			"region": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -1020,6 +1052,7 @@ func resourceHerokuAppV0() *schema.Resource {
 				MinItems: 0,
 				MaxItems: 1,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -1073,7 +1106,59 @@ func resourceHerokuAppStateUpgradeV0(ctx context.Context, rawState map[string]in
 }
 
 func resourceHerokuAppCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if err := resolveAppDefaults(diff, v.(*Config)); err != nil {
+		return err
+	}
+
+	// A plan cannot return warnings, so an overlap is only logged here, and
+	// reported by Create and Update.
+	if diff.Id() != "" && !isUnsetInConfig(diff, "buildpacks") {
+		claimBuildpacks(ctx, v.(*Config), diff.Id(), "heroku_app")
+	}
+
 	// Note: Generation is now computed based on the space, not user-configurable.
 	// Validation will happen during the apply phase when we can determine the actual generation.
+	if generation, ok := diff.GetOk("generation"); ok && !v.(*Config).canValidateFeatures(ctx, generation.(string)) {
+		logWarn(ctx, fmt.Sprintf("App generation %q is not known to this provider version, so its feature support cannot be validated", generation))
+	}
+
+	return nil
+}
+
+// resolveAppDefaults plans the provider's default team, region and space for
+// an app which does not set them. Without a default, an unset team or space
+// plans its removal, as for any optional attribute.
+func resolveAppDefaults(d *schema.ResourceDiff, config *Config) error {
+	if isUnsetInConfig(d, "organization") {
+		organization := []interface{}{}
+		if config.DefaultTeam != "" {
+			organization = []interface{}{map[string]interface{}{"name": config.DefaultTeam}}
+		}
+
+		// Keep the computed locked & personal flags of the current team.
+		old, _ := d.GetChange("organization")
+		if oldL := old.([]interface{}); len(oldL) > 0 && oldL[0] != nil &&
+			len(organization) > 0 && oldL[0].(map[string]interface{})["name"] == config.DefaultTeam {
+			organization = oldL
+		}
+
+		if err := d.SetNew("organization", organization); err != nil {
+			return err
+		}
+	}
+
+	if err := setProviderDefault(d, "space", config.DefaultSpace); err != nil {
+		return err
+	}
+
+	if isUnsetInConfig(d, "region") {
+		if config.DefaultRegion == "" {
+			return fmt.Errorf("region must be set, either on the app or in the provider's defaults block")
+		}
+		if err := d.SetNew("region", config.DefaultRegion); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
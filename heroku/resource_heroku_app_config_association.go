@@ -7,6 +7,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,13 +16,13 @@ import (
 
 func resourceHerokuAppConfigAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuAppConfigAssociationCreate,
-		Read:   resourceHerokuAppConfigAssociationRead,
-		Update: resourceHerokuAppConfigAssociationUpdate,
-		Delete: resourceHerokuAppConfigAssociationDelete,
+		CreateContext: resourceHerokuAppConfigAssociationCreate,
+		ReadContext:   resourceHerokuAppConfigAssociationRead,
+		UpdateContext: resourceHerokuAppConfigAssociationUpdate,
+		DeleteContext: resourceHerokuAppConfigAssociationDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuAppConfigAssociationImport,
+			StateContext: resourceHerokuAppConfigAssociationImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -49,16 +50,22 @@ func resourceHerokuAppConfigAssociation() *schema.Resource {
 				},
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 	}
 }
 
-func resourceHerokuAppConfigAssociationImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuAppConfigAssociationImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	noImportErr := fmt.Errorf("not possible to import this resource")
 
 	return nil, noImportErr
 }
 
-func resourceHerokuAppConfigAssociationCreate(d *schema.ResourceData, m interface{}) error {
+func resourceHerokuAppConfigAssociationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Config).Api
 
 	appId := getAppId(d)
@@ -68,34 +75,34 @@ func resourceHerokuAppConfigAssociationCreate(d *schema.ResourceData, m interfac
 	// Check for duplicates between vars & sensitive_vars
 	dupeErr := duplicateVarsChecker(vars, sensitiveVars)
 	if dupeErr != nil {
-		return dupeErr
+		return diag.FromErr(dupeErr)
 	}
 
 	// Combine Both Variables
 	combinedVars := mergeVars(vars, sensitiveVars)
 
 	// Update vars on the app
-	if err := updateVars(appId, client, nil, combinedVars); err != nil {
-		return err
+	if err := updateVars(ctx, appId, client, nil, combinedVars); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("config:%s", appId))
 
-	return resourceHerokuAppConfigAssociationRead(d, m)
+	return resourceHerokuAppConfigAssociationRead(ctx, d, m)
 }
 
-func resourceHerokuAppConfigAssociationRead(d *schema.ResourceData, m interface{}) error {
+func resourceHerokuAppConfigAssociationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Config).Api
 
 	appId := getAppId(d)
 	setErr := d.Set("app_id", appId)
 	if setErr != nil {
-		return setErr
+		return diag.FromErr(setErr)
 	}
 
-	remoteAppVars, remoteAppGetErr := retrieveConfigVars(appId, client)
+	remoteAppVars, remoteAppGetErr := retrieveConfigVars(ctx, appId, client)
 	if remoteAppGetErr != nil {
-		return remoteAppGetErr
+		return diag.FromErr(remoteAppGetErr)
 	}
 
 	vettedConfigVars, vettedSensitiveConfigVars := vetVarsForState(getVars(d), getSensitiveVars(d), remoteAppVars)
@@ -110,7 +117,7 @@ func resourceHerokuAppConfigAssociationRead(d *schema.ResourceData, m interface{
 	return nil
 }
 
-func resourceHerokuAppConfigAssociationUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceHerokuAppConfigAssociationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Config).Api
 	appId := getAppId(d)
 
@@ -123,14 +130,14 @@ func resourceHerokuAppConfigAssociationUpdate(d *schema.ResourceData, m interfac
 	allNewVars = mergeVars(newVars, newSensitiveVars)
 
 	// Update vars on the app
-	if err := updateVars(appId, client, allOldVars, allNewVars); err != nil {
-		return err
+	if err := updateVars(ctx, appId, client, allOldVars, allNewVars); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceHerokuAppConfigAssociationRead(d, m)
+	return resourceHerokuAppConfigAssociationRead(ctx, d, m)
 }
 
-func resourceHerokuAppConfigAssociationDelete(d *schema.ResourceData, m interface{}) error {
+func resourceHerokuAppConfigAssociationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*Config).Api
 	appId := getAppId(d)
 
@@ -139,8 +146,8 @@ func resourceHerokuAppConfigAssociationDelete(d *schema.ResourceData, m interfac
 	allVars := mergeVars(vars, sensitiveVars)
 
 	// Essentially execute an update to delete all the vars listed in the schema only
-	if err := updateVars(appId, client, allVars, nil); err != nil {
-		return err
+	if err := updateVars(ctx, appId, client, allVars, nil); err != nil {
+		return diag.FromErr(err)
 	}
 
 	// Remove resource from state
@@ -149,16 +156,16 @@ func resourceHerokuAppConfigAssociationDelete(d *schema.ResourceData, m interfac
 	return nil
 }
 
-func updateVars(id string, client *heroku.Service, o map[string]interface{}, n map[string]interface{}) error {
+func updateVars(ctx context.Context, id string, client *heroku.Service, o map[string]interface{}, n map[string]interface{}) error {
 	vars := constructVars(o, n)
 
-	log.Printf("[INFO] Updating config vars: *%#v", vars)
-	if _, err := client.ConfigVarUpdate(context.TODO(), id, vars); err != nil {
+	log.Printf("[INFO] Updating config vars: %s", logKeys(vars))
+	if _, err := client.ConfigVarUpdate(ctx, id, vars); err != nil {
 		return fmt.Errorf("error updating config vars: %s", err)
 	}
 
 	releases, err := client.ReleaseList(
-		context.TODO(),
+		ctx,
 		id,
 		&heroku.ListRange{Descending: true, Field: "version", Max: 1},
 	)
@@ -173,11 +180,11 @@ func updateVars(id string, client *heroku.Service, o map[string]interface{}, n m
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"succeeded"},
-		Refresh: releaseStateRefreshFunc(client, id, releases[0].ID),
-		Timeout: 20 * time.Minute,
+		Refresh: releaseStateRefreshFunc(ctx, client, id, releases[0].ID),
+		Timeout: remainingTimeout(ctx, 20*time.Minute),
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("error waiting for new release (%s) to succeed: %s", releases[0].ID, err)
 	}
 
@@ -189,7 +196,7 @@ func getVars(d *schema.ResourceData) map[string]interface{} {
 	var vars map[string]interface{}
 	if v, ok := d.GetOk("vars"); ok {
 		vs := v.(map[string]interface{})
-		log.Printf("[DEBUG] vars: %s", vs)
+		log.Printf("[DEBUG] vars: %s", logKeys(vs))
 		vars = vs
 	}
 
@@ -201,7 +208,7 @@ func getSensitiveVars(d *schema.ResourceData) map[string]interface{} {
 	var sensitiveVars map[string]interface{}
 	if v, ok := d.GetOk("sensitive_vars"); ok {
 		vs := v.(map[string]interface{})
-		log.Printf("[DEBUG] sensitive vars: %s", vs)
+		log.Printf("[DEBUG] sensitive vars: %s", logKeys(vs))
 		sensitiveVars = vs
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	heroku "github.com/heroku/heroku-go/v6"
@@ -11,13 +12,13 @@ import (
 
 func resourceHerokuAppFeature() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuAppFeatureCreate,
-		Update: resourceHerokuAppFeatureUpdate,
-		Read:   resourceHerokuAppFeatureRead,
-		Delete: resourceHerokuAppFeatureDelete,
+		CreateContext: resourceHerokuAppFeatureCreate,
+		UpdateContext: resourceHerokuAppFeatureUpdate,
+		ReadContext:   resourceHerokuAppFeatureRead,
+		DeleteContext: resourceHerokuAppFeatureDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuAppFeatureImport,
+			StateContext: resourceHerokuAppFeatureImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -51,8 +52,8 @@ func resourceHerokuAppFeature() *schema.Resource {
 	}
 }
 
-func resourceHerokuAppFeatureImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	readErr := resourceHerokuAppFeatureRead(d, meta)
+func resourceHerokuAppFeatureImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	readErr := diagnosticsError(resourceHerokuAppFeatureRead(ctx, d, meta))
 	if readErr != nil {
 		return nil, readErr
 	}
@@ -60,17 +61,17 @@ func resourceHerokuAppFeatureImport(d *schema.ResourceData, meta interface{}) ([
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceHerokuAppFeatureRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	app, id, err := parseCompositeID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	feature, err := client.AppFeatureInfo(context.TODO(), app, id)
+	feature, err := client.AppFeatureInfo(ctx, app, id)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("app_id", app)
@@ -80,7 +81,7 @@ func resourceHerokuAppFeatureRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceHerokuAppFeatureCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppFeatureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	app := d.Get("app_id").(string)
@@ -91,38 +92,38 @@ func resourceHerokuAppFeatureCreate(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[DEBUG] Feature set configuration: %#v, %#v", featureName, opts)
 
-	feature, err := client.AppFeatureUpdate(context.TODO(), app, featureName, opts)
+	feature, err := client.AppFeatureUpdate(ctx, app, featureName, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(buildCompositeID(app, feature.ID))
 
-	return resourceHerokuAppFeatureRead(d, meta)
+	return resourceHerokuAppFeatureRead(ctx, d, meta)
 }
 
-func resourceHerokuAppFeatureUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppFeatureUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	if d.HasChange("enabled") {
-		return resourceHerokuAppFeatureCreate(d, meta)
+		return resourceHerokuAppFeatureCreate(ctx, d, meta)
 	}
 
-	return resourceHerokuAppFeatureRead(d, meta)
+	return resourceHerokuAppFeatureRead(ctx, d, meta)
 }
 
-func resourceHerokuAppFeatureDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppFeatureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	app, id, err := parseCompositeID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	featureName := d.Get("name").(string)
 
 	log.Printf("[INFO] Deleting app feature %s (%s) for app %s", featureName, id, app)
 	opts := heroku.AppFeatureUpdateOpts{Enabled: false}
-	_, err = client.AppFeatureUpdate(context.TODO(), app, id, opts)
+	_, err = client.AppFeatureUpdate(ctx, app, id, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
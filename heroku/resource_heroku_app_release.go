@@ -2,11 +2,11 @@ package heroku
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,13 +15,13 @@ import (
 
 func resourceHerokuAppRelease() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuAppReleaseCreate,
-		Read:   resourceHerokuAppReleaseRead,
-		Update: resourceHerokuAppReleaseUpdate,
-		Delete: resourceHerokuAppReleaseDelete,
+		CreateContext: resourceHerokuAppReleaseCreate,
+		ReadContext:   resourceHerokuAppReleaseRead,
+		UpdateContext: resourceHerokuAppReleaseUpdate,
+		DeleteContext: resourceHerokuAppReleaseDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuAppReleaseImport,
+			StateContext: resourceHerokuAppReleaseImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,14 +63,18 @@ func resourceHerokuAppRelease() *schema.Resource {
 				Version: 0,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
 	}
 }
 
-func resourceHerokuAppReleaseCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppReleaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	// TODO: Re-enable validation after investigating test failures
-	// if err := validateArtifactForApp(client, d); err != nil {
+	// if err := validateArtifactForApp(ctx, client, d); err != nil {
 	//	return err
 	// }
 
@@ -97,10 +101,10 @@ func resourceHerokuAppReleaseCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	log.Printf("[DEBUG] Creating a new release on app: [%s]", appName)
-	newRelease, err := client.ReleaseCreate(context.TODO(), appName, opts)
+	newRelease, err := client.ReleaseCreate(ctx, appName, opts)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] New release ID: %s", newRelease.ID)
@@ -109,18 +113,18 @@ func resourceHerokuAppReleaseCreate(d *schema.ResourceData, meta interface{}) er
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"succeeded"},
-		Refresh: releaseStateRefreshFunc(client, appName, newRelease.ID),
-		Timeout: 20 * time.Minute,
+		Refresh: releaseStateRefreshFunc(ctx, client, appName, newRelease.ID),
+		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("[ERROR] Error waiting for new release (%s) to succeed: %s", newRelease.ID, err)
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("[ERROR] Error waiting for new release (%s) to succeed: %s", newRelease.ID, err)
 	}
 
 	// Set the ID after the release is successful
 	d.SetId(newRelease.ID)
 
-	return resourceHerokuAppReleaseRead(d, meta)
+	return resourceHerokuAppReleaseRead(ctx, d, meta)
 }
 
 // validateArtifactForGeneration validates artifact type compatibility with a specific generation
@@ -149,13 +153,13 @@ func validateArtifactForGeneration(generationName string, hasSlug bool, hasOci b
 }
 
 // validateArtifactForApp validates artifact type for the target app at apply time
-func validateArtifactForApp(client *heroku.Service, d *schema.ResourceData) error {
+func validateArtifactForApp(ctx context.Context, client *heroku.Service, d *schema.ResourceData) error {
 	hasSlug := d.Get("slug_id").(string) != ""
 	hasOci := d.Get("oci_image").(string) != ""
 	appID := d.Get("app_id").(string)
 
 	// Fetch app info to determine its generation
-	app, err := client.AppInfo(context.TODO(), appID)
+	app, err := client.AppInfo(ctx, appID)
 	if err != nil {
 		return fmt.Errorf("error fetching app info: %s", err)
 	}
@@ -164,15 +168,15 @@ func validateArtifactForApp(client *heroku.Service, d *schema.ResourceData) erro
 	return validateArtifactForGeneration(app.Generation.Name, hasSlug, hasOci)
 }
 
-func resourceHerokuAppReleaseRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppReleaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	appName := getAppId(d)
 
-	appRelease, err := client.ReleaseInfo(context.TODO(), appName, d.Id())
+	appRelease, err := client.ReleaseInfo(ctx, appName, d.Id())
 
 	if err != nil {
-		return fmt.Errorf("[ERROR] error retrieving app release: %s", err)
+		return diag.Errorf("[ERROR] error retrieving app release: %s", err)
 	}
 
 	d.Set("app_id", appRelease.App.ID)
@@ -197,12 +201,12 @@ func resourceHerokuAppReleaseRead(d *schema.ResourceData, meta interface{}) erro
 
 // resourceHerokuAppReleaseUpdate will be a no-op method as there is no UPDATE endpoint for the release resource
 // in the Heroku Platform APIs.
-func resourceHerokuAppReleaseUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppReleaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Detect if [description] attribute changed but not [slug_id]. If such is the case, output error.
 	// If both attributes changed, a new release will be created since [slug_id] is set to ForceNew.
 
 	if !d.HasChange("slug_id") && d.HasChange("description") {
-		return errors.New("you cannot update an existing release's description. Please create a new release instead")
+		return diag.Errorf("you cannot update an existing release's description. Please create a new release instead")
 	}
 
 	return nil
@@ -210,12 +214,12 @@ func resourceHerokuAppReleaseUpdate(d *schema.ResourceData, meta interface{}) er
 
 // resourceHerokuAppReleaseDelete will be a no-op method as there is no DELETE endpoint for the release resource
 // in the Heroku Platform APIs.
-func resourceHerokuAppReleaseDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppReleaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] There is no DELETE for release resource so this is a no-op. Resource will be removed from state.")
 	return nil
 }
 
-func resourceHerokuAppReleaseImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuAppReleaseImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	// The import function will import the current release for an app.
 	// There doesn't seem to be a compelling reason for someone to import a legacy release on an application.
 	client := meta.(*Config).Api
@@ -224,7 +228,7 @@ func resourceHerokuAppReleaseImport(d *schema.ResourceData, meta interface{}) ([
 
 	log.Printf("[INFO] Importing Release for App [%s]", appName)
 
-	appReleases, err := client.ReleaseList(context.Background(), appName, &heroku.ListRange{Descending: true, Field: "version", Max: 1})
+	appReleases, err := client.ReleaseList(ctx, appName, &heroku.ListRange{Descending: true, Field: "version", Max: 1})
 	appRelease := appReleases[0]
 
 	if err != nil {
@@ -0,0 +1,471 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+func resourceHerokuAppSetup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuAppSetupCreate,
+		ReadContext:   resourceHerokuAppSetupRead,
+		DeleteContext: resourceHerokuAppSetupDelete,
+		CustomizeDiff: resourceHerokuAppSetupCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"checksum": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+
+						"path": {
+							Type:          schema.TypeString,
+							ConflictsWith: []string{"source.0.url"},
+							Optional:      true,
+							ForceNew:      true,
+						},
+
+						"url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateSourceUrl,
+						},
+
+						"version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"env": {
+							Type:      schema.TypeMap,
+							Optional:  true,
+							ForceNew:  true,
+							Sensitive: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"buildpacks": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"team": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"personal"},
+			},
+
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"space": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"stack": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"locked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"personal": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"build_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"build_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"build_output_stream_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"failure_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"manifest_errors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"postdeploy_exit_code": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"postdeploy_output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"resolved_success_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"local_checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			// App setups provision add-ons, build and run the postdeploy script.
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+	}
+}
+
+// resourceHerokuAppSetupCustomizeDiff plans the provider's default team,
+// region and space for a new app setup which does not set them.
+func resourceHerokuAppSetupCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	// Detect changes to the content of local source archive.
+	if err := diffLocalSourceChecksum(diff, "app setup"); err != nil {
+		return err
+	}
+
+	if diff.Id() != "" {
+		return nil
+	}
+	config := v.(*Config)
+
+	if !diff.Get("personal").(bool) {
+		if err := setProviderDefault(diff, "team", config.DefaultTeam); err != nil {
+			return err
+		}
+	}
+	if err := setProviderDefault(diff, "region", config.DefaultRegion); err != nil {
+		return err
+	}
+	return setProviderDefault(diff, "space", config.DefaultSpace)
+}
+
+func resourceHerokuAppSetupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+	client := config.Api
+
+	opts := heroku.AppSetupCreateOpts{}
+
+	var checksum string
+	if v, ok := d.GetOk("source"); ok {
+		for _, s := range v.([]interface{}) {
+			blob, localChecksum, err := resolveSourceBlob(ctx, config, s.(map[string]interface{}), "app setup")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			opts.SourceBlob.Checksum = blob.Checksum
+			opts.SourceBlob.URL = blob.URL
+			opts.SourceBlob.Version = blob.Version
+			checksum = localChecksum
+		}
+	}
+
+	opts.App = getAppSetupAppOpts(d)
+	opts.Overrides = getAppSetupOverrides(d)
+
+	log.Printf("[DEBUG] Creating app setup")
+	setup, err := client.AppSetupCreate(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Error creating app setup: %s", err)
+	}
+
+	// Track the setup, even if it fails, so that its app is destroyed.
+	d.SetId(setup.ID)
+	d.Set("local_checksum", checksum)
+	log.Printf("[INFO] App setup ID: %s, app: %s", setup.ID, setup.App.Name)
+
+	log.Printf("[DEBUG] Waiting for app setup (%s) to complete", setup.ID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{"succeeded"},
+		Refresh: AppSetupStateRefreshFunc(ctx, client, setup.ID),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		// Record the failure message, manifest errors & postdeploy output.
+		resourceHerokuAppSetupRead(ctx, d, meta)
+		return diag.FromErr(err)
+	}
+
+	return resourceHerokuAppSetupRead(ctx, d, meta)
+}
+
+func getAppSetupAppOpts(d *schema.ResourceData) *struct {
+	Locked       *bool   `json:"locked,omitempty" url:"locked,omitempty,key"`
+	Name         *string `json:"name,omitempty" url:"name,omitempty,key"`
+	Organization *string `json:"organization,omitempty" url:"organization,omitempty,key"`
+	Personal     *bool   `json:"personal,omitempty" url:"personal,omitempty,key"`
+	Region       *string `json:"region,omitempty" url:"region,omitempty,key"`
+	Space        *string `json:"space,omitempty" url:"space,omitempty,key"`
+	Stack        *string `json:"stack,omitempty" url:"stack,omitempty,key"`
+} {
+	app := &struct {
+		Locked       *bool   `json:"locked,omitempty" url:"locked,omitempty,key"`
+		Name         *string `json:"name,omitempty" url:"name,omitempty,key"`
+		Organization *string `json:"organization,omitempty" url:"organization,omitempty,key"`
+		Personal     *bool   `json:"personal,omitempty" url:"personal,omitempty,key"`
+		Region       *string `json:"region,omitempty" url:"region,omitempty,key"`
+		Space        *string `json:"space,omitempty" url:"space,omitempty,key"`
+		Stack        *string `json:"stack,omitempty" url:"stack,omitempty,key"`
+	}{}
+
+	if v, ok := d.GetOk("name"); ok {
+		vs := v.(string)
+		app.Name = &vs
+	}
+	if v, ok := d.GetOk("team"); ok {
+		vs := v.(string)
+		app.Organization = &vs
+	}
+	if v, ok := d.GetOk("region"); ok {
+		vs := v.(string)
+		app.Region = &vs
+	}
+	if v, ok := d.GetOk("space"); ok {
+		vs := v.(string)
+		app.Space = &vs
+	}
+	if v, ok := d.GetOk("stack"); ok {
+		vs := v.(string)
+		app.Stack = &vs
+	}
+	if v, ok := d.GetOk("locked"); ok {
+		vb := v.(bool)
+		app.Locked = &vb
+	}
+	if v, ok := d.GetOk("personal"); ok {
+		vb := v.(bool)
+		app.Personal = &vb
+	}
+
+	return app
+}
+
+func getAppSetupOverrides(d *schema.ResourceData) *struct {
+	Buildpacks []*struct {
+		URL *string `json:"url,omitempty" url:"url,omitempty,key"`
+	} `json:"buildpacks,omitempty" url:"buildpacks,omitempty,key"`
+	Env map[string]string `json:"env,omitempty" url:"env,omitempty,key"`
+} {
+	v, ok := d.GetOk("overrides")
+	if !ok {
+		return nil
+	}
+	vL := v.([]interface{})
+	if len(vL) == 0 || vL[0] == nil {
+		return nil
+	}
+	overridesArg := vL[0].(map[string]interface{})
+
+	overrides := &struct {
+		Buildpacks []*struct {
+			URL *string `json:"url,omitempty" url:"url,omitempty,key"`
+		} `json:"buildpacks,omitempty" url:"buildpacks,omitempty,key"`
+		Env map[string]string `json:"env,omitempty" url:"env,omitempty,key"`
+	}{}
+
+	if env, ok := overridesArg["env"].(map[string]interface{}); ok && len(env) > 0 {
+		overrides.Env = make(map[string]string, len(env))
+		for k, v := range env {
+			overrides.Env[k] = v.(string)
+		}
+		log.Printf("[DEBUG] App setup env overrides: %s", logKeys(env))
+	}
+
+	if buildpacks, ok := overridesArg["buildpacks"].([]interface{}); ok {
+		for _, buildpack := range buildpacks {
+			url := buildpack.(string)
+			overrides.Buildpacks = append(overrides.Buildpacks, &struct {
+				URL *string `json:"url,omitempty" url:"url,omitempty,key"`
+			}{
+				URL: &url,
+			})
+		}
+	}
+
+	return overrides
+}
+
+func resourceHerokuAppSetupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	setup, err := client.AppSetupInfo(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("Error retrieving app setup: %s", err)
+	}
+
+	// The app setup outlives the app it created, so check the app is still there.
+	if _, err := client.AppInfo(ctx, setup.App.ID); err != nil {
+		if isNotFoundError(err) {
+			logWarn(ctx, fmt.Sprintf("App %s of app setup %s no longer exists, removing it from state", setup.App.ID, d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error retrieving app of app setup: %s", err)
+	}
+
+	d.Set("app_id", setup.App.ID)
+	d.Set("name", setup.App.Name)
+	d.Set("status", setup.Status)
+	d.Set("manifest_errors", setup.ManifestErrors)
+
+	if setup.Build != nil {
+		d.Set("build_id", setup.Build.ID)
+		d.Set("build_status", setup.Build.Status)
+		d.Set("build_output_stream_url", setup.Build.OutputStreamURL)
+	}
+	if setup.FailureMessage != nil {
+		d.Set("failure_message", *setup.FailureMessage)
+	}
+	if setup.Postdeploy != nil {
+		d.Set("postdeploy_exit_code", setup.Postdeploy.ExitCode)
+		d.Set("postdeploy_output", setup.Postdeploy.Output)
+	}
+	if setup.ResolvedSuccessURL != nil {
+		d.Set("resolved_success_url", *setup.ResolvedSuccessURL)
+	}
+
+	return nil
+}
+
+// There's no DELETE endpoint for app setups, so the app they created is
+// deleted instead.
+func resourceHerokuAppSetupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	appID := d.Get("app_id").(string)
+	if appID == "" {
+		return nil
+	}
+
+	log.Printf("[INFO] Deleting app %s of app setup %s", appID, d.Id())
+	if _, err := client.AppDelete(ctx, appID); err != nil && !isNotFoundError(err) {
+		return diag.Errorf("Error deleting app of app setup: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// Returns a resource.StateRefreshFunc that is used to watch an app setup.
+func AppSetupStateRefreshFunc(ctx context.Context, client *heroku.Service, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		setup, err := client.AppSetupInfo(ctx, id)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to get app setup status: %s (setup: %s)", err, id)
+			return nil, "", err
+		}
+
+		if setup.Status == "failed" {
+			return nil, "", appSetupError(setup)
+		}
+
+		return setup, setup.Status, nil
+	}
+}
+
+// appSetupError describes why an app setup failed, with its manifest errors,
+// failed build and postdeploy output, when there are any. The Platform API
+// reports add-on provisioning failures only in the failure message.
+func appSetupError(setup *heroku.AppSetup) error {
+	msg := fmt.Sprintf("App setup %s failed (app: %s)", setup.ID, setup.App.Name)
+	if setup.FailureMessage != nil && *setup.FailureMessage != "" {
+		msg += ": " + *setup.FailureMessage
+	}
+	if len(setup.ManifestErrors) > 0 {
+		msg += "\napp.json manifest errors:\n  " + strings.Join(setup.ManifestErrors, "\n  ")
+	}
+	if setup.Build != nil && setup.Build.Status == "failed" {
+		msg += fmt.Sprintf("\nbuild %s failed, its output is at %s", setup.Build.ID, setup.Build.OutputStreamURL)
+	}
+	if setup.Postdeploy != nil && setup.Postdeploy.Output != "" {
+		msg += fmt.Sprintf("\npostdeploy script exited %d, output follows:\n%s\n(End of postdeploy output)",
+			setup.Postdeploy.ExitCode, setup.Postdeploy.Output)
+	}
+	return fmt.Errorf("%s", msg)
+}
@@ -0,0 +1,80 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccHerokuAppSetup_Basic(t *testing.T) {
+	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHerokuAppSetupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuAppSetupConfig(appName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_app_setup.foobar", "status", "succeeded"),
+					resource.TestCheckResourceAttr(
+						"heroku_app_setup.foobar", "name", appName),
+					resource.TestCheckResourceAttrSet(
+						"heroku_app_setup.foobar", "app_id"),
+					resource.TestCheckResourceAttrSet(
+						"heroku_app_setup.foobar", "build_id"),
+					resource.TestCheckResourceAttr(
+						"heroku_app_setup.foobar", "build_status", "succeeded"),
+					resource.TestCheckResourceAttr(
+						"heroku_app_setup.foobar", "postdeploy_exit_code", "0"),
+					resource.TestMatchResourceAttr(
+						"heroku_app_setup.foobar", "postdeploy_output", regexp.MustCompile(`postdeploy howdy`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuAppSetupDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).Api
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "heroku_app_setup" {
+			continue
+		}
+
+		if _, err := client.AppInfo(context.TODO(), rs.Primary.Attributes["app_id"]); err == nil {
+			return fmt.Errorf("App of app setup still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckHerokuAppSetupConfig(appName string) string {
+	return fmt.Sprintf(`
+resource "heroku_app_setup" "foobar" {
+  name   = "%s"
+  region = "us"
+
+  source {
+    path = "test-fixtures/app-setup"
+  }
+
+  overrides {
+    env = {
+      GREETING = "howdy"
+    }
+  }
+}
+`, appName)
+}
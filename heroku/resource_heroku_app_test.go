@@ -305,6 +305,31 @@ func TestAccHerokuApp_Organization(t *testing.T) {
 	})
 }
 
+func TestAccHerokuApp_ProviderDefaults(t *testing.T) {
+	var app heroku.TeamApp
+	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	org := testAccConfig.GetOrganizationOrSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHerokuAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuAppConfig_providerDefaults(appName, org),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHerokuAppExistsOrg("heroku_app.foobar", &app),
+					testAccCheckHerokuAppAttributesOrg(&app, appName, "", org, false),
+					resource.TestCheckResourceAttr("heroku_app.foobar", "region", "us"),
+					resource.TestCheckResourceAttr("heroku_app.foobar", "organization.0.name", org),
+				),
+			},
+		},
+	})
+}
+
 // Generates a "test step" not a whole test, so that it can reuse the space.
 // See: resource_heroku_space_test.go, where this is used.
 func testStep_AccHerokuApp_Space(t *testing.T, spaceConfig, spaceName string) resource.TestStep {
@@ -834,6 +859,24 @@ resource "heroku_app" "foobar" {
 }`, appName, org)
 }
 
+func testAccCheckHerokuAppConfig_providerDefaults(appName, org string) string {
+	return fmt.Sprintf(`
+provider "heroku" {
+  defaults {
+    team   = "%s"
+    region = "us"
+  }
+}
+
+resource "heroku_app" "foobar" {
+  name = "%s"
+
+  config_vars = {
+    FOO = "bar"
+  }
+}`, org, appName)
+}
+
 func testAccCheckHerokuAppConfig_space(spaceConfig, appName, org string) string {
 	return fmt.Sprintf(`
 # heroku_space.foobar config inherited from previous steps
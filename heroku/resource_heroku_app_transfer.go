@@ -0,0 +1,284 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+// Transfers between accounts are pending until the recipient accepts or
+// declines them. Transfers by a team admin complete immediately.
+const (
+	appTransferStatePending  = "pending"
+	appTransferStateAccepted = "accepted"
+	appTransferStateDeclined = "declined"
+)
+
+func resourceHerokuAppTransfer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuAppTransferCreate,
+		ReadContext:   resourceHerokuAppTransferRead,
+		DeleteContext: resourceHerokuAppTransferDelete,
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"recipient_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"recipient_email", "recipient_team"},
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"recipient_team": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"silent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHerokuAppTransferCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	appID := getAppId(d)
+
+	app, err := client.AppInfo(ctx, appID)
+	if err != nil {
+		return diag.Errorf("Error retrieving app %s: %s", appID, err)
+	}
+
+	switch {
+	case d.Get("recipient_team").(string) != "":
+		team := d.Get("recipient_team").(string)
+		log.Printf("[INFO] Transferring app %s to team %s", appID, team)
+		if _, err := client.TeamAppTransferToTeam(ctx, appID, heroku.TeamAppTransferToTeamOpts{Owner: team}); err != nil {
+			return diag.Errorf("Error transferring app %s to team %s: %s", appID, team, err)
+		}
+		d.SetId(appID)
+
+	case app.Team != nil:
+		// Team admins transfer team apps to an account directly.
+		email := d.Get("recipient_email").(string)
+		log.Printf("[INFO] Transferring team app %s to %s", appID, email)
+		if _, err := client.TeamAppTransferToAccount(ctx, appID, heroku.TeamAppTransferToAccountOpts{Owner: email}); err != nil {
+			return diag.Errorf("Error transferring app %s to %s: %s", appID, email, err)
+		}
+		d.SetId(appID)
+
+	default:
+		opts := heroku.AppTransferCreateOpts{
+			App:       appID,
+			Recipient: d.Get("recipient_email").(string),
+		}
+		if v, ok := d.GetOk("silent"); ok {
+			silent := v.(bool)
+			opts.Silent = &silent
+		}
+
+		log.Printf("[INFO] Creating transfer of app %s to %s", appID, opts.Recipient)
+		transfer, err := client.AppTransferCreate(ctx, opts)
+		if err != nil {
+			return diag.Errorf("Error creating transfer of app %s: %s", appID, err)
+		}
+		d.SetId(transfer.ID)
+	}
+
+	return resourceHerokuAppTransferRead(ctx, d, meta)
+}
+
+// isDirectAppTransfer reports whether the transfer completed immediately,
+// without a transfer to accept, in which case its ID is the app's.
+func isDirectAppTransfer(d *schema.ResourceData) bool {
+	return d.Id() == d.Get("app_id").(string)
+}
+
+func resourceHerokuAppTransferRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	appID := getAppId(d)
+
+	if !isDirectAppTransfer(d) {
+		transfer, err := client.AppTransferInfo(ctx, d.Id())
+		if err == nil {
+			if transfer.State == appTransferStateDeclined {
+				logWarn(ctx, fmt.Sprintf("Transfer of app %s was declined by %s, so a new transfer will be planned", appID, transfer.Recipient.Email))
+				d.SetId("")
+				return nil
+			}
+			d.Set("recipient_email", transfer.Recipient.Email)
+			d.Set("state", transfer.State)
+			return nil
+		}
+		if !isNotFoundError(err) {
+			return diag.Errorf("Error retrieving app transfer %s: %s", d.Id(), err)
+		}
+		// Transfers may only be listed while they are pending, so check the
+		// app's owner once the transfer is gone.
+		log.Printf("[DEBUG] Transfer %s of app %s is gone, checking its owner: %s", d.Id(), appID, err)
+	}
+
+	app, err := client.AppInfo(ctx, appID)
+	if err != nil {
+		return diag.Errorf("Error retrieving app %s: %s", appID, err)
+	}
+
+	if !appTransferredTo(app, d.Get("recipient_email").(string), d.Get("recipient_team").(string)) {
+		logWarn(ctx, fmt.Sprintf("App %s is not owned by the transfer's recipient, so a new transfer will be planned", appID))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("state", appTransferStateAccepted)
+
+	return nil
+}
+
+// appTransferredTo reports whether app is owned by the recipient account or
+// team, which may be given by name or ID.
+func appTransferredTo(app *heroku.App, email, team string) bool {
+	if team != "" {
+		return app.Team != nil && (app.Team.Name == team || app.Team.ID == team)
+	}
+	return app.Team == nil && strings.EqualFold(app.Owner.Email, email)
+}
+
+// A pending transfer is cancelled. Completed transfers cannot be undone, so
+// removing them is a no-op which leaves the app with its new owner.
+func resourceHerokuAppTransferDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	if isDirectAppTransfer(d) || d.Get("state").(string) != appTransferStatePending {
+		log.Printf("[INFO] App transfer %s is complete, so removing it is a no-op. The app keeps its new owner.", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[INFO] Cancelling app transfer %s", d.Id())
+	if _, err := client.AppTransferDelete(ctx, d.Id()); err != nil {
+		return diag.Errorf("Error cancelling app transfer %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceHerokuAppTransferAcceptance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuAppTransferAcceptanceCreate,
+		ReadContext:   resourceHerokuAppTransferAcceptanceRead,
+		DeleteContext: resourceHerokuAppTransferAcceptanceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"transfer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      appTransferStateAccepted,
+				ValidateFunc: validation.StringInSlice([]string{appTransferStateAccepted, appTransferStateDeclined}, false),
+			},
+
+			"app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceHerokuAppTransferAcceptanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	transferID := d.Get("transfer_id").(string)
+	state := d.Get("state").(string)
+
+	log.Printf("[INFO] Setting app transfer %s to %s", transferID, state)
+	transfer, err := client.AppTransferUpdate(ctx, transferID, heroku.AppTransferUpdateOpts{State: state})
+	if err != nil {
+		return diag.Errorf("Error updating app transfer %s to %s: %s", transferID, state, err)
+	}
+
+	d.SetId(transferID)
+	d.Set("app_id", transfer.App.ID)
+
+	// Wait for the transfer to complete, so that resources depending on the
+	// app's new owner see it.
+	log.Printf("[DEBUG] Waiting for app transfer %s to be %s", transferID, state)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{appTransferStatePending},
+		Target:  []string{state},
+		Refresh: AppTransferStateRefreshFunc(ctx, client, transferID, state),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for app transfer %s to be %s: %s", transferID, state, err)
+	}
+
+	return nil
+}
+
+// Completed transfers may no longer be listed, so there is nothing to refresh.
+func resourceHerokuAppTransferAcceptanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// A no-op, as an accepted or declined transfer cannot be undone.
+func resourceHerokuAppTransferAcceptanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] App transfer %s cannot be undone, so removing its acceptance is a no-op.", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// Returns a resource.StateRefreshFunc that is used to watch an app transfer.
+// A transfer which is no longer listed is taken to have reached the target
+// state.
+func AppTransferStateRefreshFunc(ctx context.Context, client *heroku.Service, id, target string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		transfer, err := client.AppTransferInfo(ctx, id)
+		if isNotFoundError(err) {
+			log.Printf("[DEBUG] App transfer %s is no longer listed: %s", id, err)
+			return id, target, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return transfer, transfer.State, nil
+	}
+}
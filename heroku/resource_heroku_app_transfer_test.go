@@ -0,0 +1,87 @@
+package heroku
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+func TestAccHerokuAppTransfer_ToTeam(t *testing.T) {
+	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	org := testAccConfig.GetOrganizationOrSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuAppTransferConfig_ToTeam(appName, org),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_app_transfer.foobar", "state", "accepted"),
+					resource.TestCheckResourceAttrPair(
+						"heroku_app_transfer.foobar", "id", "heroku_app.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAppTransferredTo(t *testing.T) {
+	personal := &heroku.App{}
+	personal.Owner.Email = "Someone@example.com"
+
+	team := &heroku.App{}
+	team.Owner.Email = "team@herokumanager.com"
+	team.Team = &struct {
+		ID   string `json:"id" url:"id,key"`
+		Name string `json:"name" url:"name,key"`
+	}{ID: "01234567-89ab-cdef-0123-456789abcdef", Name: "some-team"}
+
+	testCases := []struct {
+		name     string
+		app      *heroku.App
+		email    string
+		team     string
+		expected bool
+	}{
+		{name: "Account", app: personal, email: "someone@example.com", expected: true},
+		{name: "Other account", app: personal, email: "other@example.com", expected: false},
+		{name: "Team", app: team, team: "some-team", expected: true},
+		{name: "Team ID", app: team, team: "01234567-89ab-cdef-0123-456789abcdef", expected: true},
+		{name: "Other team", app: team, team: "other-team", expected: false},
+		{name: "Other team ID", app: team, team: "76543210-89ab-cdef-0123-456789abcdef", expected: false},
+		{name: "Team app to account", app: team, email: "team@herokumanager.com", expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := appTransferredTo(tc.app, tc.email, tc.team); actual != tc.expected {
+				t.Fatalf("got %t, want %t", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func testAccCheckHerokuAppTransferConfig_ToTeam(appName, org string) string {
+	return fmt.Sprintf(`
+resource "heroku_app" "foobar" {
+  name   = "%s"
+  region = "us"
+
+  lifecycle {
+    ignore_changes = [organization]
+  }
+}
+
+resource "heroku_app_transfer" "foobar" {
+  app_id         = heroku_app.foobar.id
+  recipient_team = "%s"
+}
+`, appName, org)
+}
@@ -4,20 +4,35 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	heroku "github.com/heroku/heroku-go/v6"
 )
 
+// webhookIncludes are the entities app and add-on webhooks can subscribe to.
+var webhookIncludes = []string{
+	"api:addon-attachment",
+	"api:addon",
+	"api:app",
+	"api:build",
+	"api:collaborator",
+	"api:domain",
+	"api:dyno",
+	"api:formation",
+	"api:release",
+	"api:sni-endpoint",
+}
+
 func resourceHerokuAppWebhook() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuAppWebhookCreate,
-		Read:   resourceHerokuAppWebhookRead,
-		Update: resourceHerokuAppWebhookUpdate,
-		Delete: resourceHerokuAppWebhookDelete,
+		CreateContext: resourceHerokuAppWebhookCreate,
+		ReadContext:   resourceHerokuAppWebhookRead,
+		UpdateContext: resourceHerokuAppWebhookUpdate,
+		DeleteContext: resourceHerokuAppWebhookDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuAppWebhookImport,
+			StateContext: resourceHerokuAppWebhookImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,18 +58,8 @@ func resourceHerokuAppWebhook() *schema.Resource {
 				Required: true,
 				MinItems: 1,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
-					ValidateFunc: validation.StringInSlice([]string{
-						"api:addon-attachment",
-						"api:addon",
-						"api:app",
-						"api:build",
-						"api:collaborator",
-						"api:domain",
-						"api:dyno",
-						"api:formation",
-						"api:release",
-						"api:sni-endpoint"}, true),
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(webhookIncludes, true),
 				},
 			},
 
@@ -74,7 +79,7 @@ func resourceHerokuAppWebhook() *schema.Resource {
 }
 
 // Callback for schema Resource.Create
-func resourceHerokuAppWebhookCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	appId := getAppId(d)
@@ -95,9 +100,9 @@ func resourceHerokuAppWebhookCreate(d *schema.ResourceData, meta interface{}) er
 		opts.Authorization = &authorization
 	}
 
-	webhook, err := client.AppWebhookCreate(context.TODO(), appId, opts)
+	webhook, err := client.AppWebhookCreate(ctx, appId, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(webhook.ID)
@@ -106,14 +111,14 @@ func resourceHerokuAppWebhookCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 // Callback for schema Resource.Read
-func resourceHerokuAppWebhookRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	appId := getAppId(d)
 
-	webhook, err := client.AppWebhookInfo(context.TODO(), appId, d.Id())
+	webhook, err := client.AppWebhookInfo(ctx, appId, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("url", webhook.URL)
@@ -124,7 +129,7 @@ func resourceHerokuAppWebhookRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 // Callback for schema Resource.Update
-func resourceHerokuAppWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Enable Partial state mode and what we successfully committed
 	d.Partial(true)
 
@@ -154,7 +159,7 @@ func resourceHerokuAppWebhookUpdate(d *schema.ResourceData, meta interface{}) er
 	if d.HasChange("secret") {
 		if v, ok := d.GetOk("secret"); ok {
 			secret := v.(string)
-			log.Printf("[DEBUG] New Secret: %s", secret)
+			log.Printf("[DEBUG] New Secret set")
 			opts.Secret = &secret
 		} else {
 			log.Printf("[DEBUG] Secret Removed")
@@ -165,7 +170,7 @@ func resourceHerokuAppWebhookUpdate(d *schema.ResourceData, meta interface{}) er
 	if d.HasChange("authorization") {
 		if v, ok := d.GetOk("authorization"); ok {
 			authorization := v.(string)
-			log.Printf("[DEBUG] New Authorization: %s", authorization)
+			log.Printf("[DEBUG] New Authorization set")
 			opts.Authorization = &authorization
 		} else {
 			log.Printf("[DEBUG] Authorization Removed")
@@ -174,10 +179,10 @@ func resourceHerokuAppWebhookUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	log.Printf("[DEBUG] Updating Heroku webhook...")
-	_, err := client.AppWebhookUpdate(context.TODO(), appId, d.Id(), opts)
+	_, err := client.AppWebhookUpdate(ctx, appId, d.Id(), opts)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Partial(false)
@@ -186,20 +191,20 @@ func resourceHerokuAppWebhookUpdate(d *schema.ResourceData, meta interface{}) er
 }
 
 // Callback for schema Resource.Delete
-func resourceHerokuAppWebhookDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuAppWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	appId := getAppId(d)
 
-	_, err := client.AppWebhookDelete(context.TODO(), appId, d.Id())
-	return err
+	_, err := client.AppWebhookDelete(ctx, appId, d.Id())
+	return diag.FromErr(err)
 }
 
-func resourceHerokuAppWebhookImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuAppWebhookImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*Config).Api
 
 	app, id, err := parseCompositeID(d.Id())
 
-	webhook, err := client.AppWebhookInfo(context.TODO(), app, id)
+	webhook, err := client.AppWebhookInfo(ctx, app, id)
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -24,13 +25,13 @@ import (
 
 func resourceHerokuBuild() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourceHerokuBuildCreate,
-		Read:          resourceHerokuBuildRead,
-		Delete:        resourceHerokuBuildDelete,
+		CreateContext: resourceHerokuBuildCreate,
+		ReadContext:   resourceHerokuBuildRead,
+		DeleteContext: resourceHerokuBuildDelete,
 		CustomizeDiff: resourceHerokuBuildCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuBuildImport,
+			StateContext: resourceHerokuBuildImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -148,10 +149,16 @@ func resourceHerokuBuild() *schema.Resource {
 				Version: 0,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			// Builds are allowed to take a very long time,
+			// basically until the build dyno cycles (22-26 hours).
+			Create: schema.DefaultTimeout(26 * time.Hour),
+		},
 	}
 }
 
-func resourceHerokuBuildImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuBuildImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*Config).Api
 
 	app, buildID, err := parseCompositeID(d.Id())
@@ -159,7 +166,7 @@ func resourceHerokuBuildImport(d *schema.ResourceData, meta interface{}) ([]*sch
 		return nil, err
 	}
 
-	build, err := client.BuildInfo(context.Background(), app, buildID)
+	build, err := client.BuildInfo(ctx, app, buildID)
 	if err != nil {
 		return nil, err
 	}
@@ -173,14 +180,14 @@ func resourceHerokuBuildImport(d *schema.ResourceData, meta interface{}) ([]*sch
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceHerokuBuildCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuBuildCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	appID := getAppId(d)
 
 	// Apply-time validation: ensure buildpacks are not specified for Fir apps
-	if err := validateBuildpacksForApp(client, appID, d); err != nil {
-		return err
+	if err := validateBuildpacksForApp(ctx, meta.(*Config), appID, d); err != nil {
+		return diag.FromErr(err)
 	}
 
 	// Build up our creation options
@@ -209,76 +216,20 @@ func resourceHerokuBuildCreate(d *schema.ResourceData, meta interface{}) error {
 		vL := v.([]interface{})
 
 		for _, s := range vL {
-			sourceArg := s.(map[string]interface{})
-			if v, ok := sourceArg["checksum"]; ok && v != "" {
-				s := v.(string)
-				if vv, okok := sourceArg["path"]; okok && vv != "" {
-					return fmt.Errorf("source.checksum should be empty when source.path is set (checksum is auto-generated)")
-				}
-				opts.SourceBlob.Checksum = &s
-			}
-
-			if v, ok := sourceArg["version"]; ok && v != "" {
-				s := v.(string)
-				opts.SourceBlob.Version = &s
-			}
-
-			if v, ok := sourceArg["path"]; ok && v != "" {
-				path := v.(string)
-				var tarballPath string
-				fileInfo, err := os.Stat(path)
-				if err != nil {
-					return fmt.Errorf("Error stating build source path %s: %s", path, err)
-				}
-				// The checksum is "relaxed" for source directories, and not performed on the tarball, but instead purely filenames & contents.
-				// This allows empemeral runtimes like Terraform Cloud to have a "stable" checksum for a source directory that will be cloned fresh each time.
-				// The trade-off, is that the checksum is non-standard, and should not be passed to Heroku as a build parameter.
-				useRelaxedChecksum := fileInfo.IsDir()
-				if useRelaxedChecksum {
-					// Generate tarball from the directory
-					tarballPath, err = generateSourceTarball(path)
-					if err != nil {
-						return fmt.Errorf("Error generating build source tarball %s: %s", path, err)
-					}
-					defer cleanupSourceFile(tarballPath)
-					checksum, err = checksumSourceRelaxed(path)
-					if err != nil {
-						return fmt.Errorf("Error calculating relaxed checksum for directory source %s: %s", path, err)
-					}
-				} else {
-					// or simply use the path to the file
-					tarballPath = path
-					checksum, err = checksumSource(tarballPath)
-					if err != nil {
-						return fmt.Errorf("Error calculating checksum for tarball source %s: %s", tarballPath, err)
-					}
-				}
-
-				// Checksum, create, & upload source archive
-				newSource, err := client.SourceCreate(context.TODO())
-				if err != nil {
-					return fmt.Errorf("Error creating source for build: %s", err)
-				}
-				err = uploadSource(tarballPath, "PUT", newSource.SourceBlob.PutURL)
-				if err != nil {
-					return fmt.Errorf("Error uploading source for build to %s: %s", newSource.SourceBlob.PutURL, err)
-				}
-				opts.SourceBlob.URL = &newSource.SourceBlob.GetURL
-				if !useRelaxedChecksum {
-					opts.SourceBlob.Checksum = &checksum
-				}
-			} else if v, ok = sourceArg["url"]; ok && v != "" {
-				s := v.(string)
-				opts.SourceBlob.URL = &s
-			} else {
-				return fmt.Errorf("Build requires either source.path or source.url")
+			blob, localChecksum, err := resolveSourceBlob(ctx, meta.(*Config), s.(map[string]interface{}), "build")
+			if err != nil {
+				return diag.FromErr(err)
 			}
+			opts.SourceBlob.Checksum = blob.Checksum
+			opts.SourceBlob.URL = blob.URL
+			opts.SourceBlob.Version = blob.Version
+			checksum = localChecksum
 		}
 	}
 
-	build, err := client.BuildCreate(context.TODO(), appID, opts)
+	build, err := client.BuildCreate(ctx, appID, opts)
 	if err != nil {
-		return fmt.Errorf("Error creating build: %s opts %+v", err, opts)
+		return diag.Errorf("Error creating build: %s opts %+v", err, opts)
 	}
 
 	// Wait for the Build to be complete
@@ -286,27 +237,25 @@ func resourceHerokuBuildCreate(d *schema.ResourceData, meta interface{}) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"pending"},
 		Target:  []string{"succeeded"},
-		Refresh: BuildStateRefreshFunc(client, appID, build.ID),
-		// Builds are allowed to take a very long time,
-		// basically until the build dyno cycles (22-26 hours).
-		Timeout: 26 * time.Hour,
+		Refresh: BuildStateRefreshFunc(ctx, client, meta.(*Config).blobClient(), appID, build.ID),
+		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return err
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(build.ID)
 	// Capture the checksum, to diff changes in the local source directory.
 	d.Set("local_checksum", checksum)
 
-	build, err = client.BuildInfo(context.TODO(), appID, build.ID)
+	build, err = client.BuildInfo(ctx, appID, build.ID)
 	if err != nil {
-		return fmt.Errorf("Error refreshing the completed build: %s", err)
+		return diag.Errorf("Error refreshing the completed build: %s", err)
 	}
 	setErr := setBuildState(d, build, appID)
 	if setErr != nil {
-		return setErr
+		return diag.FromErr(setErr)
 	}
 
 	log.Printf("[INFO] Created build ID: %s", d.Id())
@@ -314,25 +263,25 @@ func resourceHerokuBuildCreate(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceHerokuBuildRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuBuildRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	appID := getAppId(d)
-	build, err := client.BuildInfo(context.TODO(), appID, d.Id())
+	build, err := client.BuildInfo(ctx, appID, d.Id())
 	if err != nil {
-		return fmt.Errorf("Error retrieving build: %s", err)
+		return diag.Errorf("Error retrieving build: %s", err)
 	}
 
 	setErr := setBuildState(d, build, appID)
 	if setErr != nil {
-		return setErr
+		return diag.FromErr(setErr)
 	}
 
 	return nil
 }
 
 // A no-op method as there is no DELETE build in Heroku Platform API.
-func resourceHerokuBuildDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuBuildDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] There is no DELETE for build resource so this is a no-op. Build will be removed from state.")
 	return nil
 }
@@ -344,46 +293,7 @@ func resourceHerokuBuildCustomizeDiff(ctx context.Context, diff *schema.Resource
 	}
 
 	// Detect changes to the content of local source archive.
-	if v, ok := diff.GetOk("source"); ok {
-		vL := v.([]interface{})
-		source := vL[0].(map[string]interface{})
-		if vv, okok := source["path"]; okok && vv != "" {
-			path := vv.(string)
-			var tarballPath string
-			fileInfo, err := os.Stat(path)
-			if err != nil {
-				return fmt.Errorf("Error stating build source path %s: %s", path, err)
-			}
-			useRelaxedChecksum := fileInfo.IsDir()
-			var realChecksum string
-			if useRelaxedChecksum {
-				realChecksum, err = checksumSourceRelaxed(path)
-				if err != nil {
-					return fmt.Errorf("Error calculating relaxed checksum for directory source %s: %s", path, err)
-				}
-			} else {
-				// or simply use the path to the file
-				tarballPath = path
-				realChecksum, err = checksumSource(tarballPath)
-				if err != nil {
-					return fmt.Errorf("Error calculating checksum for tarball source %s: %s", tarballPath, err)
-				}
-			}
-
-			oldChecksum, newChecksum := diff.GetChange("local_checksum")
-			log.Printf("[DEBUG] Diffing source: old '%s', new '%s', real '%s'", oldChecksum, newChecksum, realChecksum)
-			if newChecksum != realChecksum {
-				if err := diff.SetNew("local_checksum", realChecksum); err != nil {
-					return fmt.Errorf("Error updating source archive checksum: %s", err)
-				}
-				if err := diff.ForceNew("local_checksum"); err != nil {
-					return fmt.Errorf("Error forcing new source resource: %s", err)
-				}
-			}
-		}
-	}
-
-	return nil
+	return diffLocalSourceChecksum(diff, "build")
 }
 
 // validateBuildpacksForAppGeneration validates buildpack configuration against the target app's generation
@@ -413,41 +323,174 @@ func validateBuildpacksForAppGeneration(ctx context.Context, diff *schema.Resour
 	}
 
 	// Validate buildpacks against app generation
-	return validateBuildpacksForGeneration(app.Generation.Name)
+	return validateBuildpacksForGeneration(ctx, config, app.Generation.Name)
 }
 
 // validateBuildpacksForApp validates buildpack configuration at apply-time
-func validateBuildpacksForApp(client *heroku.Service, appID string, d *schema.ResourceData) error {
+func validateBuildpacksForApp(ctx context.Context, config *Config, appID string, d *schema.ResourceData) error {
 	// Only validate if buildpacks are specified
 	if _, ok := d.GetOk("buildpacks"); !ok {
 		return nil // No buildpacks specified, nothing to validate
 	}
 
 	// Fetch app info to determine its generation
-	app, err := client.AppInfo(context.TODO(), appID)
+	app, err := config.Api.AppInfo(ctx, appID)
 	if err != nil {
 		return fmt.Errorf("failed to get app info for build validation: %w", err)
 	}
 
 	// Validate buildpacks against app generation
-	return validateBuildpacksForGeneration(app.Generation.Name)
+	return validateBuildpacksForGeneration(ctx, config, app.Generation.Name)
 }
 
 // validateBuildpacksForGeneration validates buildpacks against a given generation
-func validateBuildpacksForGeneration(generationName string) error {
+func validateBuildpacksForGeneration(ctx context.Context, config *Config, generationName string) error {
 	appGeneration := generationName
 	if appGeneration == "" {
 		appGeneration = "cedar" // Default to cedar if generation is not specified
 	}
 
-	if !IsFeatureSupported(appGeneration, "app", "buildpacks") {
+	if !config.canValidateFeatures(ctx, appGeneration) {
+		// Unknown generation - let the API handle it
+		log.Printf("[WARN] Unable to validate buildpacks for unknown generation %s", appGeneration)
+		return nil
+	}
+
+	if !config.IsFeatureSupported(ctx, appGeneration, "app", "buildpacks") {
 		return fmt.Errorf("buildpacks cannot be specified for %s generation apps. Use project.toml to configure Cloud Native Buildpacks instead. See: https://devcenter.heroku.com/articles/using-multiple-buildpacks-for-an-app", appGeneration)
 	}
 
 	return nil
 }
 
-func uploadSource(filePath, httpMethod, httpUrl string) error {
+// sourceBlob is the source_blob of a build or app setup.
+type sourceBlob struct {
+	Checksum *string
+	URL      *string
+	Version  *string
+}
+
+// resolveSourceBlob returns the source blob for a source block, uploading
+// source.path when it is set. For a directory, which is uploaded as a
+// generated tarball, the returned local checksum is "relaxed", and is not
+// passed to Heroku.
+func resolveSourceBlob(ctx context.Context, config *Config, sourceArg map[string]interface{}, kind string) (sourceBlob, string, error) {
+	var blob sourceBlob
+	var checksum string
+
+	if v, ok := sourceArg["checksum"]; ok && v != "" {
+		s := v.(string)
+		if vv, okok := sourceArg["path"]; okok && vv != "" {
+			return blob, "", fmt.Errorf("source.checksum should be empty when source.path is set (checksum is auto-generated)")
+		}
+		blob.Checksum = &s
+	}
+
+	if v, ok := sourceArg["version"]; ok && v != "" {
+		s := v.(string)
+		blob.Version = &s
+	}
+
+	if v, ok := sourceArg["path"]; ok && v != "" {
+		path := v.(string)
+		var tarballPath string
+		fileInfo, err := os.Stat(path)
+		if err != nil {
+			return blob, "", fmt.Errorf("Error stating %s source path %s: %s", kind, path, err)
+		}
+		// The checksum is "relaxed" for source directories, and not performed on the tarball, but instead purely filenames & contents.
+		// This allows empemeral runtimes like Terraform Cloud to have a "stable" checksum for a source directory that will be cloned fresh each time.
+		// The trade-off, is that the checksum is non-standard, and should not be passed to Heroku as a build parameter.
+		useRelaxedChecksum := fileInfo.IsDir()
+		if useRelaxedChecksum {
+			// Generate tarball from the directory
+			tarballPath, err = generateSourceTarball(path)
+			if err != nil {
+				return blob, "", fmt.Errorf("Error generating %s source tarball %s: %s", kind, path, err)
+			}
+			defer cleanupSourceFile(tarballPath)
+			checksum, err = checksumSourceRelaxed(path)
+			if err != nil {
+				return blob, "", fmt.Errorf("Error calculating relaxed checksum for directory source %s: %s", path, err)
+			}
+		} else {
+			// or simply use the path to the file
+			tarballPath = path
+			checksum, err = checksumSource(tarballPath)
+			if err != nil {
+				return blob, "", fmt.Errorf("Error calculating checksum for tarball source %s: %s", tarballPath, err)
+			}
+		}
+
+		// Checksum, create, & upload source archive
+		newSource, err := config.Api.SourceCreate(ctx)
+		if err != nil {
+			return blob, "", fmt.Errorf("Error creating source for %s: %s", kind, err)
+		}
+		err = uploadSource(ctx, config.blobClient(), tarballPath, "PUT", newSource.SourceBlob.PutURL)
+		if err != nil {
+			return blob, "", fmt.Errorf("Error uploading source for %s to %s: %s", kind, newSource.SourceBlob.PutURL, err)
+		}
+		blob.URL = &newSource.SourceBlob.GetURL
+		if !useRelaxedChecksum {
+			blob.Checksum = &checksum
+		}
+	} else if v, ok = sourceArg["url"]; ok && v != "" {
+		s := v.(string)
+		blob.URL = &s
+	} else {
+		return blob, "", fmt.Errorf("A %s requires either source.path or source.url", kind)
+	}
+
+	return blob, checksum, nil
+}
+
+// diffLocalSourceChecksum forces a new resource when the content of a local
+// source.path has changed since it was uploaded.
+func diffLocalSourceChecksum(diff *schema.ResourceDiff, kind string) error {
+	if v, ok := diff.GetOk("source"); ok {
+		vL := v.([]interface{})
+		source := vL[0].(map[string]interface{})
+		if vv, okok := source["path"]; okok && vv != "" {
+			path := vv.(string)
+			var tarballPath string
+			fileInfo, err := os.Stat(path)
+			if err != nil {
+				return fmt.Errorf("Error stating %s source path %s: %s", kind, path, err)
+			}
+			useRelaxedChecksum := fileInfo.IsDir()
+			var realChecksum string
+			if useRelaxedChecksum {
+				realChecksum, err = checksumSourceRelaxed(path)
+				if err != nil {
+					return fmt.Errorf("Error calculating relaxed checksum for directory source %s: %s", path, err)
+				}
+			} else {
+				// or simply use the path to the file
+				tarballPath = path
+				realChecksum, err = checksumSource(tarballPath)
+				if err != nil {
+					return fmt.Errorf("Error calculating checksum for tarball source %s: %s", tarballPath, err)
+				}
+			}
+
+			oldChecksum, newChecksum := diff.GetChange("local_checksum")
+			log.Printf("[DEBUG] Diffing source: old '%s', new '%s', real '%s'", oldChecksum, newChecksum, realChecksum)
+			if newChecksum != realChecksum {
+				if err := diff.SetNew("local_checksum", realChecksum); err != nil {
+					return fmt.Errorf("Error updating source archive checksum: %s", err)
+				}
+				if err := diff.ForceNew("local_checksum"); err != nil {
+					return fmt.Errorf("Error forcing new source resource: %s", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func uploadSource(ctx context.Context, httpClient *http.Client, filePath, httpMethod, httpUrl string) error {
 	method := strings.ToUpper(httpMethod)
 	log.Printf("[DEBUG] Uploading source '%s' to %s %s", filePath, method, httpUrl)
 
@@ -461,13 +504,11 @@ func uploadSource(filePath, httpMethod, httpUrl string) error {
 	}
 	defer file.Close()
 
-	httpClient := &http.Client{}
-	req, err := http.NewRequest(method, httpUrl, file)
+	req, err := http.NewRequestWithContext(ctx, method, httpUrl, file)
 	if err != nil {
 		return fmt.Errorf("Error creating source upload request: %s", err)
 	}
 	req.ContentLength = stat.Size()
-	log.Printf("[DEBUG] Upload source request: %+v", req)
 	res, err := httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("Error uploading source: %s", err)
@@ -667,9 +708,9 @@ func generateSourceTarball(path string) (string, error) {
 }
 
 // Returns a resource.StateRefreshFunc that is used to watch a Build.
-func BuildStateRefreshFunc(client *heroku.Service, app, id string) resource.StateRefreshFunc {
+func BuildStateRefreshFunc(ctx context.Context, client *heroku.Service, logClient *http.Client, app, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		build, err := client.BuildInfo(context.TODO(), app, id)
+		build, err := client.BuildInfo(ctx, app, id)
 		if err != nil {
 			log.Printf("[DEBUG] Failed to get Build status: %s (app: %s)", err, app)
 			return nil, "", err
@@ -681,9 +722,13 @@ func BuildStateRefreshFunc(client *heroku.Service, app, id string) resource.Stat
 		}
 
 		if build.Status == "failed" {
-			resp, err := http.Get(build.OutputStreamURL)
+			req, err := http.NewRequestWithContext(ctx, "GET", build.OutputStreamURL, nil)
+			if err != nil {
+				return nil, "", fmt.Errorf("Build failed (app: %s), also failed (%s) to fetch build logs from: %s", app, err, build.OutputStreamURL)
+			}
+			resp, err := logClient.Do(req)
 			if err != nil {
-				return nil, "", fmt.Errorf("Build failed (app: %s), also failed (%s) to fetch build logs from: %s", app, resp.Status, build.OutputStreamURL)
+				return nil, "", fmt.Errorf("Build failed (app: %s), also failed (%s) to fetch build logs from: %s", app, err, build.OutputStreamURL)
 			}
 			defer resp.Body.Close()
 			buildLog, err := io.ReadAll(resp.Body)
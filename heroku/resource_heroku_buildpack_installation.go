@@ -0,0 +1,190 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// buildpackOwnership records which resource types manage each app's buildpacks
+// during a provider run, so that heroku_app.buildpacks and
+// heroku_buildpack_installation managing the same app can be warned about.
+// Existing apps are claimed when planned. Apps created in the same run have no
+// ID to claim until they are applied, so they are claimed then.
+//
+// Detection is best-effort: it only sees the resources of a single plan or
+// apply, such as not those of another Terraform configuration.
+type buildpackOwnership struct {
+	mu     sync.Mutex
+	owners map[string]map[string]bool
+}
+
+// claim records that owner manages the buildpacks of appID, and reports
+// whether another resource type does too.
+func (o *buildpackOwnership) claim(appID, owner string) bool {
+	if o == nil {
+		return false
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.owners == nil {
+		o.owners = make(map[string]map[string]bool)
+	}
+	if o.owners[appID] == nil {
+		o.owners[appID] = make(map[string]bool)
+	}
+	o.owners[appID][owner] = true
+
+	return len(o.owners[appID]) > 1
+}
+
+// claimBuildpacks claims the buildpacks of appID for owner, returning a warning
+// when another resource type manages them too.
+func claimBuildpacks(ctx context.Context, config *Config, appID, owner string) diag.Diagnostics {
+	if !config.buildpackOwners.claim(appID, owner) {
+		return nil
+	}
+
+	logWarn(ctx, fmt.Sprintf("The buildpacks of app %s are managed by both heroku_app.buildpacks and heroku_buildpack_installation", appID))
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The buildpacks of app %s are managed by both heroku_app.buildpacks and heroku_buildpack_installation", appID),
+			Detail:   "Each apply will revert the other's changes. Remove buildpacks from the heroku_app, or remove the heroku_buildpack_installation.",
+		},
+	}
+}
+
+func resourceHerokuBuildpackInstallation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuBuildpackInstallationSet,
+		ReadContext:   resourceHerokuBuildpackInstallationRead,
+		UpdateContext: resourceHerokuBuildpackInstallationSet,
+		DeleteContext: resourceHerokuBuildpackInstallationDelete,
+
+		CustomizeDiff: resourceHerokuBuildpackInstallationCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHerokuBuildpackInstallationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"buildpacks": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceHerokuBuildpackInstallationCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("app_id") {
+		// The app is created in the same apply, so its generation is checked
+		// then.
+		return nil
+	}
+
+	// A plan cannot return warnings, so an overlap is only logged here, and
+	// reported by Read.
+	appID := diff.Get("app_id").(string)
+	claimBuildpacks(ctx, v.(*Config), appID, "heroku_buildpack_installation")
+
+	client := v.(*Config).Api
+
+	app, err := client.AppInfo(ctx, appID)
+	if err != nil {
+		// The app may not exist yet, so leave it to apply time.
+		log.Printf("[DEBUG] Skipping the buildpacks generation check, as app %s could not be retrieved: %s", appID, err)
+		return nil
+	}
+
+	return validateBuildpacksForGeneration(ctx, v.(*Config), app.Generation.Name)
+}
+
+func resourceHerokuBuildpackInstallationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("app_id", d.Id())
+
+	readErr := diagnosticsError(resourceHerokuBuildpackInstallationRead(ctx, d, meta))
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Callback for schema Resource.Create and schema Resource.Update
+func resourceHerokuBuildpackInstallationSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	appID := d.Get("app_id").(string)
+
+	app, err := client.AppInfo(ctx, appID)
+	if err != nil {
+		return diag.Errorf("Error retrieving app %s: %s", appID, err)
+	}
+	if err := validateBuildpacksForGeneration(ctx, meta.(*Config), app.Generation.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	buildpacks := d.Get("buildpacks").([]interface{})
+	log.Printf("[DEBUG] Setting the buildpacks of app %s to %v", appID, buildpacks)
+	if err := updateBuildpacks(ctx, appID, client, buildpacks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(appID)
+
+	return resourceHerokuBuildpackInstallationRead(ctx, d, meta)
+}
+
+func resourceHerokuBuildpackInstallationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	buildpacks, err := retrieveBuildpacks(ctx, d.Id(), client)
+	if err != nil {
+		return diag.Errorf("Error retrieving the buildpacks of app %s: %s", d.Id(), err)
+	}
+
+	d.Set("app_id", d.Id())
+	d.Set("buildpacks", buildpacks)
+
+	// Also claims apps created in the same apply, which could not be claimed
+	// when planned.
+	return claimBuildpacks(ctx, meta.(*Config), d.Id(), "heroku_buildpack_installation")
+}
+
+// Removes all of the app's buildpacks, so that its builds detect them again.
+func resourceHerokuBuildpackInstallationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	log.Printf("[INFO] Removing the buildpacks of app %s", d.Id())
+	if err := updateBuildpacks(ctx, d.Id(), client, []interface{}{}); err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHerokuBuildpackInstallation_Basic(t *testing.T) {
+	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHerokuAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuBuildpackInstallationConfig(appName, `"heroku/nodejs"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_buildpack_installation.foobar", "buildpacks.#", "1"),
+					resource.TestCheckResourceAttr(
+						"heroku_buildpack_installation.foobar", "buildpacks.0", "heroku/nodejs"),
+				),
+			},
+			{
+				// The order of buildpacks is significant.
+				Config: testAccCheckHerokuBuildpackInstallationConfig(appName, `"heroku/ruby", "heroku/nodejs"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_buildpack_installation.foobar", "buildpacks.#", "2"),
+					resource.TestCheckResourceAttr(
+						"heroku_buildpack_installation.foobar", "buildpacks.0", "heroku/ruby"),
+					resource.TestCheckResourceAttr(
+						"heroku_buildpack_installation.foobar", "buildpacks.1", "heroku/nodejs"),
+				),
+			},
+			{
+				ResourceName:      "heroku_buildpack_installation.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestBuildpackOwnershipClaim(t *testing.T) {
+	o := &buildpackOwnership{}
+
+	if o.claim("app-1", "heroku_app") {
+		t.Fatal("a single owner should not be reported")
+	}
+	if o.claim("app-1", "heroku_app") {
+		t.Fatal("repeated claims by the same owner should not be reported")
+	}
+	if o.claim("app-2", "heroku_buildpack_installation") {
+		t.Fatal("owners of different apps should not be reported")
+	}
+	if !o.claim("app-1", "heroku_buildpack_installation") {
+		t.Fatal("a second owner of the same app should be reported")
+	}
+	if !o.claim("app-1", "heroku_app") {
+		t.Fatal("further claims of an app with two owners should be reported")
+	}
+
+	var unset *buildpackOwnership
+	if unset.claim("app-1", "heroku_app") || unset.claim("app-1", "heroku_buildpack_installation") {
+		t.Fatal("claims should not be reported without a provider run to record them")
+	}
+}
+
+func TestClaimBuildpacks_WarnsOnOverlap(t *testing.T) {
+	config := &Config{buildpackOwners: &buildpackOwnership{}}
+	ctx := context.Background()
+
+	if diags := claimBuildpacks(ctx, config, "app-1", "heroku_app"); diags != nil {
+		t.Fatalf("expected no diagnostics for a single owner, got %v", diags)
+	}
+
+	diags := claimBuildpacks(ctx, config, "app-1", "heroku_buildpack_installation")
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a warning for two owners, got %v", diags)
+	}
+}
+
+func testAccCheckHerokuBuildpackInstallationConfig(appName, buildpacks string) string {
+	return fmt.Sprintf(`
+resource "heroku_app" "foobar" {
+	name   = "%s"
+	region = "us"
+}
+
+resource "heroku_buildpack_installation" "foobar" {
+	app_id     = heroku_app.foobar.id
+	buildpacks = [%s]
+}
+`, appName, buildpacks)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -35,12 +36,12 @@ type collaborator struct {
 
 func resourceHerokuCollaborator() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuCollaboratorCreate,
-		Read:   resourceHerokuCollaboratorRead,
-		Delete: resourceHerokuCollaboratorDelete,
+		CreateContext: resourceHerokuCollaboratorCreate,
+		ReadContext:   resourceHerokuCollaboratorRead,
+		DeleteContext: resourceHerokuCollaboratorDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuCollaboratorImport,
+			StateContext: resourceHerokuCollaboratorImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,6 +58,11 @@ func resourceHerokuCollaborator() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -68,7 +74,7 @@ func resourceHerokuCollaborator() *schema.Resource {
 	}
 }
 
-func resourceHerokuCollaboratorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuCollaboratorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	opts := heroku.CollaboratorCreateOpts{}
@@ -85,24 +91,24 @@ func resourceHerokuCollaboratorCreate(d *schema.ResourceData, meta interface{})
 	opts.Silent = &vs
 
 	log.Printf("[DEBUG] Creating Heroku Collaborator: [%s]", opts.User)
-	createdCollaborator, err := client.CollaboratorCreate(context.TODO(), appID, opts)
+	createdCollaborator, err := client.CollaboratorCreate(ctx, appID, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(createdCollaborator.ID)
 	log.Printf("[INFO] New Collaborator ID: %s", d.Id())
 
-	return resourceHerokuCollaboratorRead(d, meta)
+	return resourceHerokuCollaboratorRead(ctx, d, meta)
 }
 
-func resourceHerokuCollaboratorRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuCollaboratorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
-	collaborator, err := resourceHerokuCollaboratorRetrieve(d.Id(), d.Get("app_id").(string), client)
+	collaborator, err := resourceHerokuCollaboratorRetrieve(ctx, d.Id(), d.Get("app_id").(string), client)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("app_id", collaborator.AppID)
@@ -111,14 +117,14 @@ func resourceHerokuCollaboratorRead(d *schema.ResourceData, meta interface{}) er
 	return nil
 }
 
-func resourceHerokuCollaboratorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuCollaboratorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	log.Printf("[INFO] Deleting Heroku Collaborator: [%s]", d.Id())
-	_, err := client.CollaboratorDelete(context.TODO(), getAppId(d), getEmail(d))
+	_, err := client.CollaboratorDelete(ctx, getAppId(d), getEmail(d))
 
 	if err != nil {
-		return fmt.Errorf("error deleting Collaborator: %s", err)
+		return diag.Errorf("error deleting Collaborator: %s", err)
 	}
 
 	// So long as the DELETE succeeded, remove the resource from state
@@ -132,8 +138,8 @@ func resourceHerokuCollaboratorDelete(d *schema.ResourceData, meta interface{})
 	following a similar pattern here.
 	*/
 	log.Printf("[INFO] Begin checking if [%s] has been deleted", getEmail(d))
-	retryError := resource.Retry(10*time.Second, func() *resource.RetryError {
-		_, err := client.CollaboratorInfo(context.TODO(), getAppId(d), d.Id())
+	retryError := resource.RetryContext(ctx, remainingTimeout(ctx, d.Timeout(schema.TimeoutDelete)), func() *resource.RetryError {
+		_, err := client.CollaboratorInfo(ctx, getAppId(d), d.Id())
 
 		// Debug log to check
 		log.Printf("[INFO] Is error nil when GET#show collaborator? %t", err == nil)
@@ -151,16 +157,16 @@ func resourceHerokuCollaboratorDelete(d *schema.ResourceData, meta interface{})
 	})
 
 	if retryError != nil {
-		return fmt.Errorf("[ERROR] Collaborator [%s] still exists on [%s] after checking several times", getEmail(d), getAppId(d))
+		return diag.Errorf("[ERROR] Collaborator [%s] still exists on [%s] after checking several times", getEmail(d), getAppId(d))
 	}
 
 	return nil
 }
 
-func resourceHerokuCollaboratorRetrieve(id string, appID string, client *heroku.Service) (*collaborator, error) {
+func resourceHerokuCollaboratorRetrieve(ctx context.Context, id string, appID string, client *heroku.Service) (*collaborator, error) {
 	collaborator := collaborator{Id: id, AppID: appID, Client: client}
 
-	err := collaborator.Update()
+	err := collaborator.Update(ctx)
 
 	if err != nil {
 		return nil, fmt.Errorf("[ERROR] Error retrieving collaborator: %s", err)
@@ -169,12 +175,12 @@ func resourceHerokuCollaboratorRetrieve(id string, appID string, client *heroku.
 	return &collaborator, nil
 }
 
-func (c *collaborator) Update() error {
+func (c *collaborator) Update(ctx context.Context) error {
 	var errs []error
 
 	log.Printf("[INFO] c.Id is %s", c.Id)
 
-	collaboratorInfo, err := c.Client.CollaboratorInfo(context.TODO(), c.AppID, c.Id)
+	collaboratorInfo, err := c.Client.CollaboratorInfo(ctx, c.AppID, c.Id)
 
 	if err != nil {
 		errs = append(errs, err)
@@ -192,7 +198,7 @@ func (c *collaborator) Update() error {
 	return nil
 }
 
-func resourceHerokuCollaboratorImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuCollaboratorImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*Config).Api
 
 	app, email, err := parseCompositeID(d.Id())
@@ -200,7 +206,7 @@ func resourceHerokuCollaboratorImport(d *schema.ResourceData, meta interface{})
 		return nil, err
 	}
 
-	collaboratorInfo, err := client.CollaboratorInfo(context.Background(), app, email)
+	collaboratorInfo, err := client.CollaboratorInfo(ctx, app, email)
 	if err != nil {
 		return nil, err
 	}
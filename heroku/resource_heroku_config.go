@@ -1,22 +1,25 @@
 package heroku
 
 import (
+	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"strconv"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceHerokuConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuConfigCreate,
-		Read:   resourceHerokuConfigRead,
-		Update: resourceHerokuConfigUpdate,
-		Delete: resourceHerokuConfigDelete,
+		CreateContext: resourceHerokuConfigCreate,
+		ReadContext:   resourceHerokuConfigRead,
+		UpdateContext: resourceHerokuConfigUpdate,
+		DeleteContext: resourceHerokuConfigDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuConfigImport,
+			StateContext: resourceHerokuConfigImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,32 +46,32 @@ func resourceHerokuConfig() *schema.Resource {
 
 // It will not be possible to import this resource as  heroku_config does not interact with any remote resources.
 // Therefore, this function will notify user of this inability.
-func resourceHerokuConfigImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuConfigImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 	noImportErr := fmt.Errorf("it is not possible to import heroku_config since there are no remote resources" +
 		" associated with heroku_config")
 
 	return nil, noImportErr
 }
 
-func resourceHerokuConfigCreate(d *schema.ResourceData, m interface{}) error {
+func resourceHerokuConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var vars, sensitiveVars map[string]interface{}
 
 	if v, ok := d.GetOk("vars"); ok {
 		vs := v.(map[string]interface{})
-		log.Printf("[DEBUG] vars: %v", vs)
+		log.Printf("[DEBUG] vars: %s", logKeys(vs))
 		vars = vs
 	}
 
 	if v, ok := d.GetOk("sensitive_vars"); ok {
 		vs := v.(map[string]interface{})
-		log.Printf("[DEBUG] sensitive vars: %v", vs)
+		log.Printf("[DEBUG] sensitive vars: %s", logKeys(vs))
 		sensitiveVars = vs
 	}
 
 	// Check for duplicate values. If there are duplicates, error out as a preventative measure
 	dupeErr := duplicateVarsChecker(vars, sensitiveVars)
 	if dupeErr != nil {
-		return dupeErr
+		return diag.FromErr(dupeErr)
 	}
 
 	// Set the ID to be name + epoch time for uniqueness
@@ -78,48 +81,48 @@ func resourceHerokuConfigCreate(d *schema.ResourceData, m interface{}) error {
 	// Set Resource id
 	d.SetId(fmt.Sprintf("config-%s", epochTimeString))
 
-	return resourceHerokuConfigRead(d, m)
+	return resourceHerokuConfigRead(ctx, d, m)
 }
 
-func resourceHerokuConfigRead(d *schema.ResourceData, m interface{}) (err error) {
-	err = d.Set("vars", d.Get("vars").(map[string]interface{}))
+func resourceHerokuConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := d.Set("vars", d.Get("vars").(map[string]interface{}))
 	err = d.Set("sensitive_vars", d.Get("sensitive_vars").(map[string]interface{}))
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	return nil
 }
 
-func resourceHerokuConfigUpdate(d *schema.ResourceData, m interface{}) error {
+func resourceHerokuConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var vars, sensitiveVars map[string]interface{}
 
 	if d.HasChange("vars") {
 		v := d.Get("vars")
 		vs := v.(map[string]interface{})
-		log.Printf("[DEBUG] vars: %v", vs)
+		log.Printf("[DEBUG] vars: %s", logKeys(vs))
 		vars = vs
 	}
 
 	if d.HasChange("sensitive_vars") {
 		v := d.Get("sensitive_vars")
 		vs := v.(map[string]interface{})
-		log.Printf("[DEBUG] sensitive vars: %v", vs)
+		log.Printf("[DEBUG] sensitive vars: %s", logKeys(vs))
 		sensitiveVars = vs
 	}
 
 	// Check for duplicate values. If there are duplicates, error out
 	dupeErr := duplicateVarsChecker(vars, sensitiveVars)
 	if dupeErr != nil {
-		return dupeErr
+		return diag.FromErr(dupeErr)
 	}
 
 	// If no duplicates, simply set new values in state.
-	return resourceHerokuConfigRead(d, m)
+	return resourceHerokuConfigRead(ctx, d, m)
 }
 
-func resourceHerokuConfigDelete(d *schema.ResourceData, m interface{}) error {
+func resourceHerokuConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("[INFO] There is no DELETE for config resource since no data is stored in Heroku. " +
 		"Resource will be removed from state.")
 
@@ -6,6 +6,8 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	heroku "github.com/heroku/heroku-go/v6"
@@ -13,13 +15,13 @@ import (
 
 func resourceHerokuDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuDomainCreate,
-		Read:   resourceHerokuDomainRead,
-		Update: resourceHerokuDomainUpdate,
-		Delete: resourceHerokuDomainDelete,
+		CreateContext: resourceHerokuDomainCreate,
+		ReadContext:   resourceHerokuDomainRead,
+		UpdateContext: resourceHerokuDomainUpdate,
+		DeleteContext: resourceHerokuDomainDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuDomainImport,
+			StateContext: resourceHerokuDomainImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -54,10 +56,14 @@ func resourceHerokuDomain() *schema.Resource {
 				Version: 0,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
 	}
 }
 
-func resourceHerokuDomainImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuDomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*Config).Api
 
 	app, id, err := parseCompositeID(d.Id())
@@ -66,12 +72,12 @@ func resourceHerokuDomainImport(d *schema.ResourceData, meta interface{}) ([]*sc
 	}
 	log.Printf("[INFO] Importing Domain: %s on App: %s", id, app)
 
-	do, err := client.DomainInfo(context.Background(), app, id)
+	do, err := client.DomainInfo(ctx, app, id)
 	if err != nil {
 		return nil, err
 	}
 
-	err = populateResource(d, do, client)
+	err = populateResource(ctx, d, do, client)
 	if err != nil {
 		return nil, fmt.Errorf("Error populating domain attributes: %w", err)
 	}
@@ -79,7 +85,7 @@ func resourceHerokuDomainImport(d *schema.ResourceData, meta interface{}) ([]*sc
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceHerokuDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	appID := d.Get("app_id").(string)
 	opts := heroku.DomainCreateOpts{
@@ -92,22 +98,28 @@ func resourceHerokuDomainCreate(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Domain create configuration: %#v, %#v", appID, opts)
 
-	do, err := client.DomainCreate(context.TODO(), appID, opts)
+	do, err := client.DomainCreate(ctx, appID, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	err = populateResource(d, do, client)
+	err = populateResource(ctx, d, do, client)
 	if err != nil {
-		return fmt.Errorf("Error populating domain attributes: %w", err)
+		return diag.Errorf("Error populating domain attributes: %s", err)
 	}
 
-	config := meta.(*Config)
-	time.Sleep(time.Duration(config.PostDomainCreateDelay) * time.Second)
+	if do.CName == nil || *do.CName == "" {
+		config := meta.(*Config)
+		waitForReadiness(ctx, fmt.Sprintf("domain (%s)", do.ID),
+			domainReadyStateRefreshFunc(ctx, client, appID, do.ID),
+			time.Duration(config.PostDomainCreateDelay)*time.Second)
+
+		return resourceHerokuDomainRead(ctx, d, meta)
+	}
 
 	return nil
 }
 
-func resourceHerokuDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	appID := d.Get("app_id").(string)
 	opts := heroku.DomainUpdateOpts{}
@@ -117,58 +129,77 @@ func resourceHerokuDomainUpdate(d *schema.ResourceData, meta interface{}) error
 		opts.SniEndpoint = &v
 	}
 
-	do, err := client.DomainUpdate(context.TODO(), appID, d.Id(), opts)
+	do, err := client.DomainUpdate(ctx, appID, d.Id(), opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	err = populateResource(d, do, client)
+	err = populateResource(ctx, d, do, client)
 	if err != nil {
-		return fmt.Errorf("Error populating domain attributes: %w", err)
+		return diag.Errorf("Error populating domain attributes: %s", err)
 	}
 
 	return nil
 }
 
-func resourceHerokuDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	log.Printf("[INFO] Deleting Domain: %s", d.Id())
 
 	// Destroy the domain
-	_, err := client.DomainDelete(context.TODO(), d.Get("app_id").(string), d.Id())
+	_, err := client.DomainDelete(ctx, d.Get("app_id").(string), d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting domain: %s", err)
+		return diag.Errorf("Error deleting domain: %s", err)
 	}
 
 	return nil
 }
 
-func resourceHerokuDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	appID := d.Get("app_id").(string)
-	do, err := client.DomainInfo(context.TODO(), appID, d.Id())
+	do, err := client.DomainInfo(ctx, appID, d.Id())
 	if err != nil {
-		return fmt.Errorf("Error retrieving domain: %s", err)
+		return diag.Errorf("Error retrieving domain: %s", err)
 	}
 
 	log.Printf("[INFO] Reading Domain: %s", d.Id())
-	err = populateResource(d, do, client)
+	err = populateResource(ctx, d, do, client)
 	if err != nil {
-		return fmt.Errorf("Error populating domain attributes: %w", err)
+		return diag.Errorf("Error populating domain attributes: %s", err)
 	}
 
 	return nil
 }
 
-func populateResource(d *schema.ResourceData, do *heroku.Domain, client *heroku.Service) error {
+// domainReadyStateRefreshFunc reports a domain as ready once its cname is
+// populated.
+func domainReadyStateRefreshFunc(ctx context.Context, client *heroku.Service, appID, domainID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		do, err := client.DomainInfo(ctx, appID, domainID)
+		if err != nil {
+			log.Printf("[DEBUG] Domain (%s) not yet readable: %s", domainID, err)
+			return domainID, "pending", nil
+		}
+
+		if do.CName == nil || *do.CName == "" {
+			log.Printf("[DEBUG] Domain (%s) cname not yet populated", domainID)
+			return do, "pending", nil
+		}
+
+		return do, "ready", nil
+	}
+}
+
+func populateResource(ctx context.Context, d *schema.ResourceData, do *heroku.Domain, client *heroku.Service) error {
 	d.SetId(do.ID)
 	d.Set("app_id", do.App.ID)
 	d.Set("hostname", do.Hostname)
 	d.Set("cname", do.CName)
 	// Do not capture SNI Endpoint when ACM is active
-	hasACM, err := retrieveAcm(do.App.ID, client)
+	hasACM, err := retrieveAcm(ctx, do.App.ID, client)
 	if err != nil {
 		return err
 	}
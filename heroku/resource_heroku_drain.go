@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,12 +16,12 @@ import (
 
 func resourceHerokuDrain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuDrainCreate,
-		Read:   resourceHerokuDrainRead,
-		Delete: resourceHerokuDrainDelete,
+		CreateContext: resourceHerokuDrainCreate,
+		ReadContext:   resourceHerokuDrainRead,
+		DeleteContext: resourceHerokuDrainDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuDrainImport,
+			StateContext: resourceHerokuDrainImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -59,12 +60,16 @@ func resourceHerokuDrain() *schema.Resource {
 				Version: 0,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+		},
 	}
 }
 
 const retryableError = `App hasn't yet been assigned a log channel. Please try again momentarily.`
 
-func resourceHerokuDrainImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuDrainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*Config).Api
 
 	result := strings.Split(d.Id(), ":")
@@ -90,7 +95,7 @@ func resourceHerokuDrainImport(d *schema.ResourceData, meta interface{}) ([]*sch
 		return nil, fmt.Errorf("the heroku_drain import ID should consist of 2 or 3 strings separated by a colon")
 	}
 
-	dr, err := client.LogDrainInfo(context.Background(), app, id)
+	dr, err := client.LogDrainInfo(ctx, app, id)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +108,7 @@ func resourceHerokuDrainImport(d *schema.ResourceData, meta interface{}) ([]*sch
 		d.Set("url", dr.URL)
 	}
 
-	foundApp, err := resourceHerokuAppRetrieve(app, client)
+	foundApp, err := resourceHerokuAppRetrieve(ctx, app, client)
 	if err != nil {
 		return nil, err
 	}
@@ -114,34 +119,32 @@ func resourceHerokuDrainImport(d *schema.ResourceData, meta interface{}) ([]*sch
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceHerokuDrainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuDrainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	appID := d.Get("app_id").(string)
 
 	// Check if app supports traditional drains (Cedar generation only)
-	if err := validateAppSupportsTraditionalDrains(client, appID); err != nil {
-		return err
+	if err := validateAppSupportsTraditionalDrains(ctx, meta.(*Config), appID); err != nil {
+		return diag.FromErr(err)
 	}
 
 	var url string
 	if v, ok := d.GetOk("url"); ok {
 		vs := v.(string)
-		log.Printf("[DEBUG] drain url: %s", vs)
 		url = vs
 	}
 
 	if v, ok := d.GetOk("sensitive_url"); ok {
 		vs := v.(string)
-		log.Printf("[DEBUG] drain sensitive_url: %s", vs)
 		url = vs
 	}
 
-	log.Printf("[DEBUG] Drain create configuration: %#v, %#v", appID, url)
+	log.Printf("[DEBUG] Creating drain for app %s", appID)
 
 	var dr *heroku.LogDrain
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-		d, err := client.LogDrainCreate(context.TODO(), appID, heroku.LogDrainCreateOpts{URL: url})
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		d, err := client.LogDrainCreate(ctx, appID, heroku.LogDrainCreateOpts{URL: url})
 		if err != nil {
 			if strings.Contains(err.Error(), retryableError) {
 				return resource.RetryableError(err)
@@ -152,25 +155,25 @@ func resourceHerokuDrainCreate(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Drain ID: %s", d.Id())
 
 	d.SetId(dr.ID)
 
-	return resourceHerokuDrainRead(d, meta)
+	return resourceHerokuDrainRead(ctx, d, meta)
 }
 
-func resourceHerokuDrainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuDrainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	log.Printf("[INFO] Deleting drain: %s", d.Id())
 
 	// Destroy the drain
-	_, err := client.LogDrainDelete(context.TODO(), d.Get("app_id").(string), d.Id())
+	_, err := client.LogDrainDelete(ctx, d.Get("app_id").(string), d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting drain: %s", err)
+		return diag.Errorf("Error deleting drain: %s", err)
 	}
 
 	log.Printf("[INFO] Deleted drain: %s", d.Id())
@@ -180,12 +183,12 @@ func resourceHerokuDrainDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceHerokuDrainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuDrainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
-	dr, err := client.LogDrainInfo(context.TODO(), d.Get("app_id").(string), d.Id())
+	dr, err := client.LogDrainInfo(ctx, d.Get("app_id").(string), d.Id())
 	if err != nil {
-		return fmt.Errorf("Error retrieving drain: %s", err)
+		return diag.Errorf("Error retrieving drain: %s", err)
 	}
 
 	d.Set("token", dr.Token)
@@ -202,13 +205,13 @@ func resourceHerokuDrainRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 // validateAppSupportsTraditionalDrains checks if the app supports traditional log drains (Cedar generation only)
-func validateAppSupportsTraditionalDrains(client *heroku.Service, appID string) error {
-	app, err := client.AppInfo(context.TODO(), appID)
+func validateAppSupportsTraditionalDrains(ctx context.Context, config *Config, appID string) error {
+	app, err := config.Api.AppInfo(ctx, appID)
 	if err != nil {
 		return fmt.Errorf("error fetching app info: %s", err)
 	}
 
-	if IsFeatureSupported(app.Generation.Name, "app", "otel") {
+	if config.IsFeatureSupported(ctx, app.Generation.Name, "app", "otel") {
 		return fmt.Errorf("traditional log drains are not supported for Fir generation apps. App '%s' is %s generation. Use heroku_telemetry_drain for Fir apps", app.Name, app.Generation.Name)
 	}
 
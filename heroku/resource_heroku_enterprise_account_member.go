@@ -0,0 +1,223 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+// enterpriseAccountPermissions are the permissions an enterprise account
+// member may be granted.
+var enterpriseAccountPermissions = []string{"view", "create", "manage", "billing"}
+
+func enterpriseAccountPermissionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice(enterpriseAccountPermissions, false),
+		},
+	}
+}
+
+func resourceHerokuEnterpriseAccountMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuEnterpriseAccountMemberCreate,
+		ReadContext:   resourceHerokuEnterpriseAccountMemberRead,
+		UpdateContext: resourceHerokuEnterpriseAccountMemberUpdate,
+		DeleteContext: resourceHerokuEnterpriseAccountMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHerokuEnterpriseAccountMemberImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"permissions": enterpriseAccountPermissionsSchema(),
+
+			"federated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"two_factor_authentication": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Callback for schema.ResourceImporter
+func resourceHerokuEnterpriseAccountMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	enterpriseAccountID, email, err := parseCompositeID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("enterprise_account_id", enterpriseAccountID)
+	d.Set("email", email)
+
+	readErr := diagnosticsError(resourceHerokuEnterpriseAccountMemberRead(ctx, d, meta))
+	if readErr != nil {
+		return nil, readErr
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("Could not find member %s of enterprise account %s", email, enterpriseAccountID)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// Callback for schema Resource.Create
+func resourceHerokuEnterpriseAccountMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	enterpriseAccountID := d.Get("enterprise_account_id").(string)
+	email := d.Get("email").(string)
+	federated := d.Get("federated").(bool)
+
+	opts := heroku.EnterpriseAccountMemberCreateOpts{
+		User:        email,
+		Permissions: getEnterpriseAccountPermissions(d.Get("permissions").(*schema.Set)),
+		Federated:   &federated,
+	}
+
+	log.Printf("[DEBUG] Adding %s to enterprise account %s with permissions %v", email, enterpriseAccountID, opts.Permissions)
+	if _, err := client.EnterpriseAccountMemberCreate(ctx, enterpriseAccountID, opts); err != nil {
+		return diag.Errorf("Error adding %s to enterprise account %s: %s", email, enterpriseAccountID, err)
+	}
+
+	d.SetId(buildCompositeID(enterpriseAccountID, email))
+	return resourceHerokuEnterpriseAccountMemberRead(ctx, d, meta)
+}
+
+// Callback for schema Resource.Read
+func resourceHerokuEnterpriseAccountMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	enterpriseAccountID, email, err := parseCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	members, err := listEnterpriseAccountMembers(ctx, client, enterpriseAccountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var found *heroku.EnterpriseAccountMember
+	for i := range members {
+		if strings.EqualFold(members[i].User.Email, email) {
+			found = &members[i]
+			break
+		}
+	}
+
+	if found == nil {
+		logWarn(ctx, fmt.Sprintf("%s is no longer a member of enterprise account %s, removing it from state", email, enterpriseAccountID))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("enterprise_account_id", found.EnterpriseAccount.ID)
+	d.Set("email", found.User.Email)
+	d.Set("user_id", found.User.ID)
+	d.Set("permissions", enterpriseAccountMemberPermissions(found))
+	d.Set("two_factor_authentication", found.TwoFactorAuthentication)
+
+	return nil
+}
+
+// Callback for schema Resource.Update
+func resourceHerokuEnterpriseAccountMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	enterpriseAccountID, email, err := parseCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("permissions") {
+		opts := heroku.EnterpriseAccountMemberUpdateOpts{
+			Permissions: getEnterpriseAccountPermissions(d.Get("permissions").(*schema.Set)),
+		}
+
+		log.Printf("[DEBUG] Updating permissions of %s in enterprise account %s to %v", email, enterpriseAccountID, opts.Permissions)
+		if _, err := client.EnterpriseAccountMemberUpdate(ctx, enterpriseAccountID, email, opts); err != nil {
+			return diag.Errorf("Error updating permissions of %s in enterprise account %s: %s", email, enterpriseAccountID, err)
+		}
+	}
+
+	return resourceHerokuEnterpriseAccountMemberRead(ctx, d, meta)
+}
+
+// Callback for schema Resource.Delete
+func resourceHerokuEnterpriseAccountMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	enterpriseAccountID, email, err := parseCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Removing %s from enterprise account %s", email, enterpriseAccountID)
+	if _, err := client.EnterpriseAccountMemberDelete(ctx, enterpriseAccountID, email); err != nil {
+		return diag.Errorf("Error removing %s from enterprise account %s: %s", email, enterpriseAccountID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func listEnterpriseAccountMembers(ctx context.Context, client *heroku.Service, enterpriseAccountID string) ([]heroku.EnterpriseAccountMember, error) {
+	members, err := listAllByID(func(lr *heroku.ListRange) ([]heroku.EnterpriseAccountMember, error) {
+		return client.EnterpriseAccountMemberList(ctx, enterpriseAccountID, lr)
+	}, func(m heroku.EnterpriseAccountMember) string { return m.ID })
+	if err != nil {
+		return nil, fmt.Errorf("Error listing members of enterprise account %s: %s", enterpriseAccountID, err)
+	}
+	return members, nil
+}
+
+func getEnterpriseAccountPermissions(set *schema.Set) []string {
+	permissions := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		permissions = append(permissions, v.(string))
+	}
+	sort.Strings(permissions)
+	return permissions
+}
+
+func enterpriseAccountMemberPermissions(member *heroku.EnterpriseAccountMember) []string {
+	permissions := make([]string, 0, len(member.Permissions))
+	for _, p := range member.Permissions {
+		permissions = append(permissions, p.Name)
+	}
+	sort.Strings(permissions)
+	return permissions
+}
@@ -0,0 +1,93 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccHerokuEnterpriseAccountMember_Basic(t *testing.T) {
+	enterpriseAccountID := testAccConfig.GetEnterpriseAccountIDOrSkip(t)
+	testUser := testAccConfig.GetUserOrSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHerokuEnterpriseAccountMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuEnterpriseAccountMemberConfig(enterpriseAccountID, testUser, `"view"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_enterprise_account_member.foobar", "email", testUser),
+					resource.TestCheckResourceAttr(
+						"heroku_enterprise_account_member.foobar", "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(
+						"heroku_enterprise_account_member.foobar", "permissions.*", "view"),
+					resource.TestCheckResourceAttrSet(
+						"heroku_enterprise_account_member.foobar", "user_id"),
+				),
+			},
+			{
+				Config: testAccCheckHerokuEnterpriseAccountMemberConfig(enterpriseAccountID, testUser, `"view", "create"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_enterprise_account_member.foobar", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr(
+						"heroku_enterprise_account_member.foobar", "permissions.*", "create"),
+				),
+			},
+			{
+				ResourceName:            "heroku_enterprise_account_member.foobar",
+				ImportStateId:           buildCompositeID(enterpriseAccountID, testUser),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"federated"},
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuEnterpriseAccountMemberDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).Api
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "heroku_enterprise_account_member" {
+			continue
+		}
+
+		enterpriseAccountID, email, err := parseCompositeID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		members, err := listEnterpriseAccountMembers(context.TODO(), client, enterpriseAccountID)
+		if err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			if strings.EqualFold(member.User.Email, email) {
+				return fmt.Errorf("%s is still a member of enterprise account %s", email, enterpriseAccountID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckHerokuEnterpriseAccountMemberConfig(enterpriseAccountID, email, permissions string) string {
+	return fmt.Sprintf(`
+resource "heroku_enterprise_account_member" "foobar" {
+	enterprise_account_id = "%s"
+	email                 = "%s"
+	permissions           = [%s]
+}
+`, enterpriseAccountID, email, permissions)
+}
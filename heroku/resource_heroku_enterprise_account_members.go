@@ -0,0 +1,257 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+// resourceHerokuEnterpriseAccountMembers authoritatively manages the members
+// of an enterprise account: members missing from the configuration are
+// removed. The authenticated user is never removed, so that Terraform does not
+// lock itself out of the enterprise account.
+func resourceHerokuEnterpriseAccountMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuEnterpriseAccountMembersCreate,
+		ReadContext:   resourceHerokuEnterpriseAccountMembersRead,
+		UpdateContext: resourceHerokuEnterpriseAccountMembersUpdate,
+		DeleteContext: resourceHerokuEnterpriseAccountMembersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHerokuEnterpriseAccountMembersImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"member": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"permissions": enterpriseAccountPermissionsSchema(),
+
+						"federated": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceHerokuEnterpriseAccountMembersImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("enterprise_account_id", d.Id())
+
+	readErr := diagnosticsError(resourceHerokuEnterpriseAccountMembersRead(ctx, d, meta))
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceHerokuEnterpriseAccountMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseAccountID := d.Get("enterprise_account_id").(string)
+
+	if err := syncEnterpriseAccountMembers(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(enterpriseAccountID)
+
+	return resourceHerokuEnterpriseAccountMembersRead(ctx, d, meta)
+}
+
+func resourceHerokuEnterpriseAccountMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	enterpriseAccountID := d.Id()
+
+	members, err := listEnterpriseAccountMembers(ctx, client, enterpriseAccountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	account, err := getAccount(ctx, meta)
+	if err != nil {
+		return diag.Errorf("Error retrieving the authenticated account: %s", err)
+	}
+
+	// The authenticated user is only recorded when configured, as it is never
+	// removed.
+	configured := desiredEnterpriseAccountMembers(d.Get("member").(*schema.Set))
+
+	result := make([]map[string]interface{}, 0, len(members))
+	for i := range members {
+		email := members[i].User.Email
+		member, ok := configured[strings.ToLower(email)]
+		if !ok && strings.EqualFold(email, account.Email) {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"email":       email,
+			"permissions": enterpriseAccountMemberPermissions(&members[i]),
+			// The Platform API does not return whether a membership is
+			// federated, so the recorded value is kept.
+			"federated": member.federated,
+		})
+	}
+
+	d.Set("enterprise_account_id", enterpriseAccountID)
+	d.Set("member", result)
+
+	return nil
+}
+
+func resourceHerokuEnterpriseAccountMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("member") {
+		if err := syncEnterpriseAccountMembers(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceHerokuEnterpriseAccountMembersRead(ctx, d, meta)
+}
+
+// Removes the configured members, except for the authenticated user. Members
+// added outside of Terraform since the last apply are left in place.
+func resourceHerokuEnterpriseAccountMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	enterpriseAccountID := d.Id()
+
+	account, err := getAccount(ctx, meta)
+	if err != nil {
+		return diag.Errorf("Error retrieving the authenticated account: %s", err)
+	}
+
+	for email := range desiredEnterpriseAccountMembers(d.Get("member").(*schema.Set)) {
+		if strings.EqualFold(email, account.Email) {
+			log.Printf("[INFO] Leaving the authenticated user %s in enterprise account %s", account.Email, enterpriseAccountID)
+			continue
+		}
+
+		log.Printf("[INFO] Removing %s from enterprise account %s", email, enterpriseAccountID)
+		if _, err := client.EnterpriseAccountMemberDelete(ctx, enterpriseAccountID, email); err != nil && !isNotFoundError(err) {
+			return diag.Errorf("Error removing %s from enterprise account %s: %s", email, enterpriseAccountID, err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// enterpriseAccountMemberConfig is the configuration of a member block.
+type enterpriseAccountMemberConfig struct {
+	permissions []string
+	federated   bool
+}
+
+// desiredEnterpriseAccountMembers returns the members of a member set, keyed
+// by lowercased email.
+func desiredEnterpriseAccountMembers(set *schema.Set) map[string]enterpriseAccountMemberConfig {
+	desired := make(map[string]enterpriseAccountMemberConfig)
+	for _, v := range set.List() {
+		m := v.(map[string]interface{})
+		email := strings.ToLower(m["email"].(string))
+		desired[email] = enterpriseAccountMemberConfig{
+			permissions: getEnterpriseAccountPermissions(m["permissions"].(*schema.Set)),
+			federated:   m["federated"].(bool),
+		}
+	}
+	return desired
+}
+
+// syncEnterpriseAccountMembers adds, updates and removes members so that the
+// enterprise account matches the configuration.
+func syncEnterpriseAccountMembers(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Config).Api
+	enterpriseAccountID := d.Get("enterprise_account_id").(string)
+
+	account, err := getAccount(ctx, meta)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the authenticated account: %s", err)
+	}
+
+	members, err := listEnterpriseAccountMembers(ctx, client, enterpriseAccountID)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string][]string, len(members))
+	for i := range members {
+		current[strings.ToLower(members[i].User.Email)] = enterpriseAccountMemberPermissions(&members[i])
+	}
+
+	oldMembers, newMembers := d.GetChange("member")
+	recorded := desiredEnterpriseAccountMembers(oldMembers.(*schema.Set))
+	desired := desiredEnterpriseAccountMembers(newMembers.(*schema.Set))
+
+	for email, member := range desired {
+		existing, ok := current[email]
+
+		// Whether a membership is federated cannot be updated, so the member
+		// is removed and added again.
+		if previous, recordedOk := recorded[email]; ok && recordedOk && previous.federated != member.federated {
+			log.Printf("[INFO] Removing %s from enterprise account %s to change whether its membership is federated", email, enterpriseAccountID)
+			if _, err := client.EnterpriseAccountMemberDelete(ctx, enterpriseAccountID, email); err != nil {
+				return fmt.Errorf("Error removing %s from enterprise account %s: %s", email, enterpriseAccountID, err)
+			}
+			ok = false
+		}
+
+		switch {
+		case !ok:
+			log.Printf("[DEBUG] Adding %s to enterprise account %s with permissions %v", email, enterpriseAccountID, member.permissions)
+			federated := member.federated
+			opts := heroku.EnterpriseAccountMemberCreateOpts{User: email, Permissions: member.permissions, Federated: &federated}
+			if _, err := client.EnterpriseAccountMemberCreate(ctx, enterpriseAccountID, opts); err != nil {
+				return fmt.Errorf("Error adding %s to enterprise account %s: %s", email, enterpriseAccountID, err)
+			}
+		case !reflect.DeepEqual(existing, member.permissions):
+			log.Printf("[DEBUG] Updating permissions of %s in enterprise account %s to %v", email, enterpriseAccountID, member.permissions)
+			opts := heroku.EnterpriseAccountMemberUpdateOpts{Permissions: member.permissions}
+			if _, err := client.EnterpriseAccountMemberUpdate(ctx, enterpriseAccountID, email, opts); err != nil {
+				return fmt.Errorf("Error updating permissions of %s in enterprise account %s: %s", email, enterpriseAccountID, err)
+			}
+		}
+	}
+
+	for email := range current {
+		if _, ok := desired[email]; ok {
+			continue
+		}
+		if strings.EqualFold(email, account.Email) {
+			log.Printf("[DEBUG] Leaving the authenticated user %s in enterprise account %s", account.Email, enterpriseAccountID)
+			continue
+		}
+
+		log.Printf("[INFO] Removing unmanaged member %s from enterprise account %s", email, enterpriseAccountID)
+		if _, err := client.EnterpriseAccountMemberDelete(ctx, enterpriseAccountID, email); err != nil {
+			return fmt.Errorf("Error removing %s from enterprise account %s: %s", email, enterpriseAccountID, err)
+		}
+	}
+
+	return nil
+}
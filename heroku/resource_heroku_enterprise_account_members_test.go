@@ -0,0 +1,52 @@
+package heroku
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHerokuEnterpriseAccountMembers_Basic(t *testing.T) {
+	enterpriseAccountID := testAccConfig.GetEnterpriseAccountIDOrSkip(t)
+	testUser := testAccConfig.GetUserOrSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuEnterpriseAccountMembersConfig(enterpriseAccountID, testUser),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_enterprise_account_members.foobar", "id", enterpriseAccountID),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"heroku_enterprise_account_members.foobar", "member.*", map[string]string{
+							"email":         testUser,
+							"permissions.#": "2",
+						}),
+				),
+			},
+			{
+				ResourceName:      "heroku_enterprise_account_members.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuEnterpriseAccountMembersConfig(enterpriseAccountID, email string) string {
+	return fmt.Sprintf(`
+resource "heroku_enterprise_account_members" "foobar" {
+	enterprise_account_id = "%s"
+
+	member {
+		email       = "%s"
+		permissions = ["view", "create"]
+	}
+}
+`, enterpriseAccountID, email)
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	heroku "github.com/heroku/heroku-go/v6"
@@ -30,13 +31,13 @@ type formation struct {
 
 func resourceHerokuFormation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuFormationCreate,
-		Read:   resourceHerokuFormationRead,
-		Update: resourceHerokuFormationUpdate,
-		Delete: resourceHerokuFormationDelete,
+		CreateContext: resourceHerokuFormationCreate,
+		ReadContext:   resourceHerokuFormationRead,
+		UpdateContext: resourceHerokuFormationUpdate,
+		DeleteContext: resourceHerokuFormationDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuFormationImport,
+			StateContext: resourceHerokuFormationImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,14 +75,14 @@ func resourceHerokuFormation() *schema.Resource {
 	}
 }
 
-func resourceHerokuFormationRead(d *schema.ResourceData, meta interface{}) (err error) {
+func resourceHerokuFormationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	appID := getAppId(d)
 
-	formation, err := resourceHerokuFormationRetrieve(d.Id(), appID, client)
+	formation, err := resourceHerokuFormationRetrieve(ctx, d.Id(), appID, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	err = d.Set("app_id", formation.Formation.AppID)
@@ -89,12 +90,12 @@ func resourceHerokuFormationRead(d *schema.ResourceData, meta interface{}) (err
 	err = d.Set("quantity", formation.Formation.Quantity)
 	err = d.Set("size", formation.Formation.Size)
 
-	return err
+	return diag.FromErr(err)
 }
 
 // resourceHerokuFormationCreate method will execute an UPDATE to the formation.
 // There is no CREATE method on the formation endpoint.
-func resourceHerokuFormationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuFormationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	opts := heroku.FormationUpdateOpts{}
@@ -102,9 +103,9 @@ func resourceHerokuFormationCreate(d *schema.ResourceData, meta interface{}) err
 	appID := getAppId(d)
 
 	// check if appID is valid
-	_, err := doesHerokuAppExist(appID, client)
+	_, err := doesHerokuAppExist(ctx, appID, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if v, ok := d.GetOk("size"); ok {
@@ -123,18 +124,18 @@ func resourceHerokuFormationCreate(d *schema.ResourceData, meta interface{}) err
 	opts.Quantity = &quantity
 
 	log.Printf("[DEBUG] Updating %s formation...", appID)
-	f, err := client.FormationUpdate(context.TODO(), appID, getFormationType(d), opts)
+	f, err := client.FormationUpdate(ctx, appID, getFormationType(d), opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(f.ID)
 	log.Printf("[INFO] Formation ID: %s", d.Id())
 
-	return resourceHerokuFormationRead(d, meta)
+	return resourceHerokuFormationRead(ctx, d, meta)
 }
 
-func resourceHerokuFormationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuFormationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Enable Partial state mode and what we successfully committed
 	d.Partial(true)
 
@@ -161,27 +162,27 @@ func resourceHerokuFormationUpdate(d *schema.ResourceData, meta interface{}) err
 	appID := getAppId(d)
 
 	// check if appID is valid
-	_, err := doesHerokuAppExist(appID, client)
+	_, err := doesHerokuAppExist(ctx, appID, client)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[DEBUG] Updating Heroku formation...")
-	updatedFormation, err := client.FormationUpdate(context.TODO(),
+	updatedFormation, err := client.FormationUpdate(ctx,
 		appID, getFormationType(d), opts)
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(updatedFormation.ID)
 
 	d.Partial(false)
 
-	return resourceHerokuFormationRead(d, meta)
+	return resourceHerokuFormationRead(ctx, d, meta)
 }
 
 // There's no DELETE endpoint for the formation resource so this function will be a no-op.
-func resourceHerokuFormationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuFormationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] There is no DELETE for formation resource so this is a no-op. Resource will be removed from state.")
 	return nil
 }
@@ -204,10 +205,10 @@ func getFormationType(d *schema.ResourceData) string {
 	return formationType
 }
 
-func resourceHerokuFormationRetrieve(id string, appID string, client *heroku.Service) (*formation, error) {
+func resourceHerokuFormationRetrieve(ctx context.Context, id string, appID string, client *heroku.Service) (*formation, error) {
 	formation := formation{Id: id, Client: client}
 
-	err := formation.GetInfo(appID)
+	err := formation.GetInfo(ctx, appID)
 
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving formation: %s", err)
@@ -216,13 +217,13 @@ func resourceHerokuFormationRetrieve(id string, appID string, client *heroku.Ser
 	return &formation, nil
 }
 
-func (f *formation) GetInfo(appID string) error {
+func (f *formation) GetInfo(ctx context.Context, appID string) error {
 	var err error
 
 	log.Printf("[INFO] The formation's app is %s", appID)
 	log.Printf("[INFO] f.Id is %s", f.Id)
 
-	formation, err := f.Client.FormationInfo(context.TODO(), appID, f.Id)
+	formation, err := f.Client.FormationInfo(ctx, appID, f.Id)
 	if err != nil {
 		return err
 	} else {
@@ -238,7 +239,7 @@ func (f *formation) GetInfo(appID string) error {
 	return nil
 }
 
-func resourceHerokuFormationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuFormationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*Config).Api
 
 	app, formationType, err := parseCompositeID(d.Id())
@@ -246,7 +247,7 @@ func resourceHerokuFormationImport(d *schema.ResourceData, meta interface{}) ([]
 		return nil, err
 	}
 
-	formation, err := client.FormationInfo(context.Background(), app, formationType)
+	formation, err := client.FormationInfo(ctx, app, formationType)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,342 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+func resourceHerokuFormationBatch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuFormationBatchSet,
+		ReadContext:   resourceHerokuFormationBatchRead,
+		UpdateContext: resourceHerokuFormationBatchSet,
+		DeleteContext: resourceHerokuFormationBatchDelete,
+		CustomizeDiff: resourceHerokuFormationBatchCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHerokuFormationBatchImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"formation": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Set:      formationBatchHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"quantity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"size": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"remove_unlisted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// formationBatchHash hashes a formation by its values, comparing sizes
+// case-insensitively, as Cedar returns capitalized sizes, e.g. "Standard-1X".
+func formationBatchHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return schema.HashString(fmt.Sprintf("%s-%d-%s",
+		m["type"].(string), m["quantity"].(int), strings.ToLower(m["size"].(string))))
+}
+
+// getFormationBatchUpdates returns the formations configured for the batch,
+// by process type. Duplicate process types are rejected when planned, by
+// duplicateFormationTypes.
+func getFormationBatchUpdates(d resourceGetter) map[string]map[string]interface{} {
+	formations := make(map[string]map[string]interface{})
+	if v, ok := d.GetOk("formation"); ok {
+		for _, f := range v.(*schema.Set).List() {
+			formation := f.(map[string]interface{})
+			formations[formation["type"].(string)] = formation
+		}
+	}
+	return formations
+}
+
+// duplicateFormationTypes returns the sorted process types listed by more than
+// one formation of the set.
+func duplicateFormationTypes(formations *schema.Set) []string {
+	counts := make(map[string]int)
+	for _, f := range formations.List() {
+		counts[f.(map[string]interface{})["type"].(string)]++
+	}
+
+	var duplicates []string
+	for formationType, count := range counts {
+		if count > 1 {
+			duplicates = append(duplicates, formationType)
+		}
+	}
+	sort.Strings(duplicates)
+	return duplicates
+}
+
+// resourceGetter is implemented by both schema.ResourceData and
+// schema.ResourceDiff.
+type resourceGetter interface {
+	GetOk(string) (interface{}, bool)
+}
+
+func resourceHerokuFormationBatchSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	appID := getAppId(d)
+
+	formations := getFormationBatchUpdates(d)
+	types := make([]string, 0, len(formations))
+	for formationType := range formations {
+		types = append(types, formationType)
+	}
+	sort.Strings(types)
+
+	opts := heroku.FormationBatchUpdateOpts{}
+	for _, formationType := range types {
+		formation := formations[formationType]
+		quantity := formation["quantity"].(int)
+		size := formation["size"].(string)
+		opts.Updates = append(opts.Updates, formationBatchUpdate(formationType, &quantity, &size))
+	}
+
+	if d.Get("remove_unlisted").(bool) {
+		current, err := client.FormationList(ctx, appID, nil)
+		if err != nil {
+			return diag.Errorf("Error listing formations of app %s: %s", appID, err)
+		}
+		for _, f := range current {
+			if _, ok := formations[f.Type]; !ok && f.Quantity > 0 {
+				log.Printf("[DEBUG] Scaling unlisted process type %s of app %s to 0", f.Type, appID)
+				zero := 0
+				opts.Updates = append(opts.Updates, formationBatchUpdate(f.Type, &zero, nil))
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Updating formations of app %s: %s", appID, types)
+	if _, err := client.FormationBatchUpdate(ctx, appID, opts); err != nil {
+		return diag.Errorf("Error updating formations of app %s: %s", appID, err)
+	}
+
+	d.SetId(appID)
+
+	return resourceHerokuFormationBatchRead(ctx, d, meta)
+}
+
+// formationBatchUpdateItem is the element type of
+// heroku.FormationBatchUpdateOpts.Updates.
+type formationBatchUpdateItem = struct {
+	DynoSize *struct {
+		ID   *string `json:"id,omitempty" url:"id,omitempty,key"`
+		Name *string `json:"name,omitempty" url:"name,omitempty,key"`
+	} `json:"dyno_size,omitempty" url:"dyno_size,omitempty,key"`
+	Quantity *int   `json:"quantity,omitempty" url:"quantity,omitempty,key"`
+	Type     string `json:"type" url:"type,key"`
+}
+
+func formationBatchUpdate(formationType string, quantity *int, size *string) formationBatchUpdateItem {
+	update := formationBatchUpdateItem{
+		Quantity: quantity,
+		Type:     formationType,
+	}
+	if size != nil {
+		update.DynoSize = &struct {
+			ID   *string `json:"id,omitempty" url:"id,omitempty,key"`
+			Name *string `json:"name,omitempty" url:"name,omitempty,key"`
+		}{
+			Name: size,
+		}
+	}
+	return update
+}
+
+func resourceHerokuFormationBatchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	current, err := client.FormationList(ctx, d.Id(), nil)
+	if err != nil {
+		if isNotFoundError(err) {
+			logWarn(ctx, fmt.Sprintf("App %s no longer exists, removing its formations from state", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error listing formations of app %s: %s", d.Id(), err)
+	}
+
+	// Only the listed process types are managed, unless remove_unlisted is
+	// set, in which case any other type that is running is drift.
+	listed := getFormationBatchUpdates(d)
+	removeUnlisted := d.Get("remove_unlisted").(bool)
+
+	var formations []interface{}
+	for _, f := range current {
+		if _, ok := listed[f.Type]; !ok && !(removeUnlisted && f.Quantity > 0) {
+			continue
+		}
+		formations = append(formations, map[string]interface{}{
+			"type":     f.Type,
+			"quantity": f.Quantity,
+			"size":     f.Size,
+		})
+	}
+
+	d.Set("app_id", d.Id())
+	if err := d.Set("formation", formations); err != nil {
+		return diag.Errorf("Error setting formations: %s", err)
+	}
+
+	return nil
+}
+
+// There's no DELETE endpoint for formations, so this is a no-op which leaves
+// the app's dynos running.
+func resourceHerokuFormationBatchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] There is no DELETE for formation resources so this is a no-op. Resource will be removed from state.")
+	d.SetId("")
+	return nil
+}
+
+func resourceHerokuFormationBatchImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Config).Api
+
+	app, err := client.AppInfo(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := client.FormationList(ctx, app.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Import every process type, as none are listed yet.
+	var formations []interface{}
+	for _, f := range current {
+		formations = append(formations, map[string]interface{}{
+			"type":     f.Type,
+			"quantity": f.Quantity,
+			"size":     f.Size,
+		})
+	}
+
+	d.SetId(app.ID)
+	d.Set("app_id", app.ID)
+	d.Set("remove_unlisted", false)
+	if err := d.Set("formation", formations); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceHerokuFormationBatchCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("formation") {
+		return nil
+	}
+	if duplicates := duplicateFormationTypes(diff.Get("formation").(*schema.Set)); len(duplicates) > 0 {
+		return fmt.Errorf("process types %v are listed by more than one formation, each process type may only be listed once", duplicates)
+	}
+
+	if !diff.NewValueKnown("app_id") {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange("formation") {
+		return nil
+	}
+
+	appID := diff.Get("app_id").(string)
+	formations := getFormationBatchUpdates(diff)
+	types := make([]string, 0, len(formations))
+	for formationType := range formations {
+		types = append(types, formationType)
+	}
+
+	return validateFormationTypes(ctx, meta.(*Config).Api, appID, types)
+}
+
+// validateFormationTypes checks that each process type is defined by the
+// slug of the app's current release. Apps without a current release built
+// from a slug, such as new apps or container and Cloud Native Buildpacks
+// apps, cannot be checked.
+func validateFormationTypes(ctx context.Context, client *heroku.Service, appID string, types []string) error {
+	releases, err := client.ReleaseList(ctx, appID, &heroku.ListRange{Descending: true, Field: "version", Max: 10})
+	if err != nil {
+		// The app may not exist yet, such as when it is replaced.
+		log.Printf("[DEBUG] Unable to list releases of app %s to check process types: %s", appID, err)
+		return nil
+	}
+
+	var slugID string
+	for _, release := range releases {
+		if release.Current {
+			if release.Slug != nil {
+				slugID = release.Slug.ID
+			}
+			break
+		}
+	}
+	if slugID == "" {
+		log.Printf("[DEBUG] App %s has no current release with a slug, so its process types cannot be checked", appID)
+		return nil
+	}
+
+	slug, err := client.SlugInfo(ctx, appID, slugID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving slug %s of app %s: %s", slugID, appID, err)
+	}
+
+	defined := make([]string, 0, len(slug.ProcessTypes))
+	for processType := range slug.ProcessTypes {
+		defined = append(defined, processType)
+	}
+	sort.Strings(defined)
+
+	var undefined []string
+	for _, formationType := range types {
+		if _, ok := slug.ProcessTypes[formationType]; !ok {
+			undefined = append(undefined, formationType)
+		}
+	}
+	if len(undefined) > 0 {
+		sort.Strings(undefined)
+		return fmt.Errorf("process types %v are not defined by the current release of app %s, which defines %v", undefined, appID, defined)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package heroku
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccHerokuFormationBatch_Basic(t *testing.T) {
+	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	slugID := testAccConfig.GetSlugIDOrSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuFormationBatchConfig(appName, slugID, "web", "basic", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_formation_batch.foobar", "formation.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"heroku_formation_batch.foobar", "formation.*", map[string]string{
+							"type":     "web",
+							"quantity": "1",
+						}),
+				),
+			},
+			{
+				Config: testAccCheckHerokuFormationBatchConfig(appName, slugID, "web", "basic", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"heroku_formation_batch.foobar", "formation.*", map[string]string{
+							"type":     "web",
+							"quantity": "2",
+						}),
+				),
+			},
+			{
+				ResourceName:            "heroku_formation_batch.foobar",
+				ImportStateId:           appName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"formation"},
+			},
+		},
+	})
+}
+
+func TestAccHerokuFormationBatch_UndefinedType(t *testing.T) {
+	appName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	slugID := testAccConfig.GetSlugIDOrSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuFormationBatchConfig(appName, slugID, "web", "basic", 1),
+			},
+			{
+				Config:      testAccCheckHerokuFormationBatchConfig(appName, slugID, "not-a-process-type", "basic", 1),
+				ExpectError: regexp.MustCompile(`process types \[not-a-process-type\] are not defined`),
+			},
+		},
+	})
+}
+
+func TestDuplicateFormationTypes(t *testing.T) {
+	formations := schema.NewSet(formationBatchHash, []interface{}{
+		map[string]interface{}{"type": "web", "quantity": 1, "size": "basic"},
+		map[string]interface{}{"type": "worker", "quantity": 1, "size": "basic"},
+		map[string]interface{}{"type": "web", "quantity": 2, "size": "basic"},
+	})
+	if duplicates := duplicateFormationTypes(formations); !reflect.DeepEqual(duplicates, []string{"web"}) {
+		t.Fatalf("got duplicates %v, want [web]", duplicates)
+	}
+
+	formations.Remove(map[string]interface{}{"type": "web", "quantity": 2, "size": "basic"})
+	if duplicates := duplicateFormationTypes(formations); duplicates != nil {
+		t.Fatalf("got duplicates %v, want none", duplicates)
+	}
+}
+
+func testAccCheckHerokuFormationBatchConfig(appName, slugID, formationType, dynoSize string, dynoQuant int) string {
+	return fmt.Sprintf(`
+resource "heroku_app" "foobar" {
+    name = "%s"
+    region = "us"
+}
+resource "heroku_app_release" "foobar-release" {
+	app_id = heroku_app.foobar.id
+	slug_id = "%s"
+}
+resource "heroku_formation_batch" "foobar" {
+	app_id = heroku_app.foobar.id
+
+	formation {
+		type = "%s"
+		size = "%s"
+		quantity = %d
+	}
+
+	depends_on = [heroku_app_release.foobar-release]
+}
+`, appName, slugID, formationType, dynoSize, dynoQuant)
+}
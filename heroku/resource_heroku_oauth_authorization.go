@@ -0,0 +1,210 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+func resourceHerokuOAuthAuthorization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuOAuthAuthorizationCreate,
+		ReadContext:   resourceHerokuOAuthAuthorizationRead,
+		UpdateContext: resourceHerokuOAuthAuthorizationUpdate,
+		DeleteContext: resourceHerokuOAuthAuthorizationDelete,
+		CustomizeDiff: resourceHerokuOAuthAuthorizationCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"global",
+						"identity",
+						"read",
+						"write",
+						"read-protected",
+						"write-protected",
+					}, false),
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"expires_in": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"client_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			// Changing any of the keepers regenerates the token in place. Their
+			// values are arbitrary and are never sent to Heroku.
+			"keepers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHerokuOAuthAuthorizationCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange("keepers") {
+		return diff.SetNewComputed("token")
+	}
+	return nil
+}
+
+func resourceHerokuOAuthAuthorizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	opts := heroku.OAuthAuthorizationCreateOpts{}
+
+	if v, ok := d.GetOk("scope"); ok {
+		for _, scope := range v.(*schema.Set).List() {
+			opts.Scope = append(opts.Scope, scope.(string))
+		}
+	} else {
+		// Heroku requires a scope, so default to the one the CLI uses.
+		opts.Scope = []string{"global"}
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		description := v.(string)
+		opts.Description = &description
+	}
+
+	if v, ok := d.GetOk("expires_in"); ok {
+		expiresIn := v.(int)
+		opts.ExpiresIn = &expiresIn
+	}
+
+	if v, ok := d.GetOk("client_id"); ok {
+		clientID := v.(string)
+		opts.Client = &clientID
+	}
+
+	log.Printf("[DEBUG] Creating OAuth authorization with scope %v", opts.Scope)
+	authorization, err := client.OAuthAuthorizationCreate(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Error creating OAuth authorization: %s", err)
+	}
+
+	d.SetId(authorization.ID)
+	log.Printf("[INFO] OAuth authorization ID: %s", d.Id())
+
+	setOAuthAuthorizationToken(d, authorization)
+
+	return resourceHerokuOAuthAuthorizationRead(ctx, d, meta)
+}
+
+func resourceHerokuOAuthAuthorizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	authorization, err := client.OAuthAuthorizationInfo(ctx, d.Id())
+	if isNotFoundError(err) {
+		logWarn(ctx, fmt.Sprintf("OAuth authorization %s no longer exists, removing it from state", d.Id()))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("Error retrieving OAuth authorization: %s", err)
+	}
+
+	d.Set("scope", authorization.Scope)
+	d.Set("description", authorization.Description)
+	d.Set("user_id", authorization.User.ID)
+	if authorization.Client != nil {
+		d.Set("client_id", authorization.Client.ID)
+	} else {
+		d.Set("client_id", "")
+	}
+	setOAuthAuthorizationToken(d, authorization)
+
+	return nil
+}
+
+// setOAuthAuthorizationToken sets the token of the authorization, when it is
+// returned. Otherwise, the token already in state is kept.
+func setOAuthAuthorizationToken(d *schema.ResourceData, authorization *heroku.OAuthAuthorization) {
+	if authorization.AccessToken != nil && authorization.AccessToken.Token != "" {
+		d.Set("token", authorization.AccessToken.Token)
+	}
+}
+
+func resourceHerokuOAuthAuthorizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		opts := heroku.OAuthAuthorizationUpdateOpts{
+			Description: &description,
+		}
+
+		log.Printf("[DEBUG] Updating OAuth authorization %s", d.Id())
+		if _, err := client.OAuthAuthorizationUpdate(ctx, d.Id(), opts); err != nil {
+			return diag.Errorf("Error updating OAuth authorization: %s", err)
+		}
+	}
+
+	if d.HasChange("keepers") {
+		log.Printf("[INFO] Regenerating the token of OAuth authorization %s", d.Id())
+		authorization, err := client.OAuthAuthorizationRegenerate(ctx, d.Id())
+		if err != nil {
+			return diag.Errorf("Error regenerating the token of OAuth authorization: %s", err)
+		}
+		setOAuthAuthorizationToken(d, authorization)
+	}
+
+	return resourceHerokuOAuthAuthorizationRead(ctx, d, meta)
+}
+
+func resourceHerokuOAuthAuthorizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	log.Printf("[INFO] Deleting OAuth authorization: %s", d.Id())
+	if _, err := client.OAuthAuthorizationDelete(ctx, d.Id()); err != nil {
+		return diag.Errorf("Error deleting OAuth authorization: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
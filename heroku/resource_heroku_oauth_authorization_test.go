@@ -0,0 +1,84 @@
+package heroku
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccHerokuOAuthAuthorization_Basic(t *testing.T) {
+	var token string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuOAuthAuthorizationConfig("deploy bot", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_oauth_authorization.foobar", "description", "deploy bot"),
+					resource.TestCheckTypeSetElemAttr(
+						"heroku_oauth_authorization.foobar", "scope.*", "read"),
+					resource.TestCheckResourceAttrSet(
+						"heroku_oauth_authorization.foobar", "token"),
+					testAccCheckHerokuOAuthAuthorizationToken("heroku_oauth_authorization.foobar", &token, false),
+				),
+			},
+			{
+				Config: testAccCheckHerokuOAuthAuthorizationConfig("renamed deploy bot", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_oauth_authorization.foobar", "description", "renamed deploy bot"),
+					testAccCheckHerokuOAuthAuthorizationToken("heroku_oauth_authorization.foobar", &token, false),
+				),
+			},
+			{
+				Config: testAccCheckHerokuOAuthAuthorizationConfig("renamed deploy bot", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHerokuOAuthAuthorizationToken("heroku_oauth_authorization.foobar", &token, true),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckHerokuOAuthAuthorizationToken compares the authorization's
+// token with the one of the previous step, which it then replaces.
+func testAccCheckHerokuOAuthAuthorizationToken(n string, token *string, regenerated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("OAuth authorization not found: %s", n)
+		}
+
+		current := rs.Primary.Attributes["token"]
+		if *token != "" && (current != *token) != regenerated {
+			if regenerated {
+				return fmt.Errorf("OAuth authorization token was not regenerated")
+			}
+			return fmt.Errorf("OAuth authorization token changed unexpectedly")
+		}
+		*token = current
+
+		return nil
+	}
+}
+
+func testAccCheckHerokuOAuthAuthorizationConfig(description, rotation string) string {
+	return fmt.Sprintf(`
+resource "heroku_oauth_authorization" "foobar" {
+  scope       = ["read"]
+  description = "%s"
+  expires_in  = 3600
+
+  keepers = {
+    rotation = "%s"
+  }
+}
+`, description, rotation)
+}
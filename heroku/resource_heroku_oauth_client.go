@@ -0,0 +1,151 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+func resourceHerokuOAuthClient() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuOAuthClientCreate,
+		ReadContext:   resourceHerokuOAuthClientRead,
+		UpdateContext: resourceHerokuOAuthClientUpdate,
+		DeleteContext: resourceHerokuOAuthClientDelete,
+		CustomizeDiff: resourceHerokuOAuthClientCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"redirect_uri": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+
+			// Changing rotation_trigger rotates the secret in place. Its value
+			// is arbitrary and is never sent to Heroku.
+			"rotation_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ignores_delinquent": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceHerokuOAuthClientCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange("rotation_trigger") {
+		return diff.SetNewComputed("secret")
+	}
+	return nil
+}
+
+func resourceHerokuOAuthClientCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	opts := heroku.OAuthClientCreateOpts{
+		Name:        d.Get("name").(string),
+		RedirectURI: d.Get("redirect_uri").(string),
+	}
+
+	log.Printf("[DEBUG] Creating OAuth client %s", opts.Name)
+	oauthClient, err := client.OAuthClientCreate(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Error creating OAuth client: %s", err)
+	}
+
+	d.SetId(oauthClient.ID)
+	log.Printf("[INFO] OAuth client ID: %s", d.Id())
+
+	return resourceHerokuOAuthClientRead(ctx, d, meta)
+}
+
+func resourceHerokuOAuthClientRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	oauthClient, err := client.OAuthClientInfo(ctx, d.Id())
+	if isNotFoundError(err) {
+		logWarn(ctx, fmt.Sprintf("OAuth client %s no longer exists, removing it from state", d.Id()))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("Error retrieving OAuth client: %s", err)
+	}
+
+	d.Set("name", oauthClient.Name)
+	d.Set("redirect_uri", oauthClient.RedirectURI)
+	d.Set("secret", oauthClient.Secret)
+	if oauthClient.IgnoresDelinquent != nil {
+		d.Set("ignores_delinquent", *oauthClient.IgnoresDelinquent)
+	}
+
+	return nil
+}
+
+func resourceHerokuOAuthClientUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	if d.HasChanges("name", "redirect_uri") {
+		opts := heroku.OAuthClientUpdateOpts{}
+		if d.HasChange("name") {
+			name := d.Get("name").(string)
+			opts.Name = &name
+		}
+		if d.HasChange("redirect_uri") {
+			redirectURI := d.Get("redirect_uri").(string)
+			opts.RedirectURI = &redirectURI
+		}
+
+		log.Printf("[DEBUG] Updating OAuth client %s", d.Id())
+		if _, err := client.OAuthClientUpdate(ctx, d.Id(), opts); err != nil {
+			return diag.Errorf("Error updating OAuth client: %s", err)
+		}
+	}
+
+	if d.HasChange("rotation_trigger") {
+		log.Printf("[INFO] Rotating the secret of OAuth client %s", d.Id())
+		if _, err := client.OAuthClientRotateCredentials(ctx, d.Id()); err != nil {
+			return diag.Errorf("Error rotating the secret of OAuth client: %s", err)
+		}
+	}
+
+	return resourceHerokuOAuthClientRead(ctx, d, meta)
+}
+
+func resourceHerokuOAuthClientDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	log.Printf("[INFO] Deleting OAuth client: %s", d.Id())
+	if _, err := client.OAuthClientDelete(ctx, d.Id()); err != nil {
+		return diag.Errorf("Error deleting OAuth client: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package heroku
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccHerokuOAuthClient_Basic(t *testing.T) {
+	name := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	var secret string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuOAuthClientConfig(name, "https://example.com/auth/callback", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_oauth_client.foobar", "name", name),
+					resource.TestCheckResourceAttr(
+						"heroku_oauth_client.foobar", "redirect_uri", "https://example.com/auth/callback"),
+					resource.TestCheckResourceAttrSet(
+						"heroku_oauth_client.foobar", "secret"),
+					testAccCheckHerokuOAuthClientSecret("heroku_oauth_client.foobar", &secret, false),
+				),
+			},
+			{
+				Config: testAccCheckHerokuOAuthClientConfig(name, "https://example.com/auth/other-callback", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_oauth_client.foobar", "redirect_uri", "https://example.com/auth/other-callback"),
+					testAccCheckHerokuOAuthClientSecret("heroku_oauth_client.foobar", &secret, false),
+				),
+			},
+			{
+				Config: testAccCheckHerokuOAuthClientConfig(name, "https://example.com/auth/other-callback", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckHerokuOAuthClientSecret("heroku_oauth_client.foobar", &secret, true),
+				),
+			},
+			{
+				ResourceName:            "heroku_oauth_client.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_trigger"},
+			},
+		},
+	})
+}
+
+// testAccCheckHerokuOAuthClientSecret compares the client's secret with the
+// one of the previous step, which it then replaces.
+func testAccCheckHerokuOAuthClientSecret(n string, secret *string, rotated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("OAuth client not found: %s", n)
+		}
+
+		current := rs.Primary.Attributes["secret"]
+		if *secret != "" && (current != *secret) != rotated {
+			if rotated {
+				return fmt.Errorf("OAuth client secret was not rotated")
+			}
+			return fmt.Errorf("OAuth client secret changed unexpectedly")
+		}
+		*secret = current
+
+		return nil
+	}
+}
+
+func testAccCheckHerokuOAuthClientConfig(name, redirectURI, rotationTrigger string) string {
+	return fmt.Sprintf(`
+resource "heroku_oauth_client" "foobar" {
+  name             = "%s"
+  redirect_uri     = "%s"
+  rotation_trigger = "%s"
+}
+`, name, redirectURI, rotationTrigger)
+}
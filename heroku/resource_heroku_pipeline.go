@@ -6,6 +6,7 @@ import (
 	"log"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,13 +15,15 @@ import (
 
 func resourceHerokuPipeline() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuPipelineCreate,
-		Update: resourceHerokuPipelineUpdate,
-		Read:   resourceHerokuPipelineRead,
-		Delete: resourceHerokuPipelineDelete,
+		CreateContext: resourceHerokuPipelineCreate,
+		UpdateContext: resourceHerokuPipelineUpdate,
+		ReadContext:   resourceHerokuPipelineRead,
+		DeleteContext: resourceHerokuPipelineDelete,
+
+		CustomizeDiff: resourceHerokuPipelineCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuPipelineImport,
+			StateContext: resourceHerokuPipelineImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -58,10 +61,10 @@ func resourceHerokuPipeline() *schema.Resource {
 	}
 }
 
-func resourceHerokuPipelineImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuPipelineImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*Config).Api
 
-	p, err := client.PipelineInfo(context.TODO(), d.Id())
+	p, err := client.PipelineInfo(ctx, d.Id())
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +75,7 @@ func resourceHerokuPipelineImport(d *schema.ResourceData, meta interface{}) ([]*
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceHerokuPipelineCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	opts := heroku.PipelineCreateOpts{}
@@ -102,9 +105,9 @@ func resourceHerokuPipelineCreate(d *schema.ResourceData, meta interface{}) erro
 		opts.Owner.ID = ownerID
 		opts.Owner.Type = ownerType
 	} else {
-		authUser, authGetUserErr := client.AccountInfo(context.TODO())
+		authUser, authGetUserErr := client.AccountInfo(ctx)
 		if authGetUserErr != nil {
-			return authGetUserErr
+			return diag.FromErr(authGetUserErr)
 		}
 
 		opts.Owner.ID = authUser.ID
@@ -116,19 +119,19 @@ func resourceHerokuPipelineCreate(d *schema.ResourceData, meta interface{}) erro
 
 	log.Printf("[DEBUG] Pipeline create configuration: %#v", opts)
 
-	p, err := client.PipelineCreate(context.TODO(), opts)
+	p, err := client.PipelineCreate(ctx, opts)
 	if err != nil {
-		return fmt.Errorf("Error creating pipeline: %s", err)
+		return diag.Errorf("Error creating pipeline: %s", err)
 	}
 
 	d.SetId(p.ID)
 
 	log.Printf("[INFO] Pipeline ID: %s", d.Id())
 
-	return resourceHerokuPipelineRead(d, meta)
+	return resourceHerokuPipelineRead(ctx, d, meta)
 }
 
-func resourceHerokuPipelineUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	if d.HasChange("name") {
@@ -137,23 +140,23 @@ func resourceHerokuPipelineUpdate(d *schema.ResourceData, meta interface{}) erro
 			Name: &name,
 		}
 
-		_, err := client.PipelineUpdate(context.TODO(), d.Id(), opts)
+		_, err := client.PipelineUpdate(ctx, d.Id(), opts)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceHerokuPipelineRead(d, meta)
+	return resourceHerokuPipelineRead(ctx, d, meta)
 }
 
-func resourceHerokuPipelineDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	log.Printf("[INFO] Deleting pipeline: %s", d.Id())
 
-	_, err := client.PipelineDelete(context.TODO(), d.Id())
+	_, err := client.PipelineDelete(ctx, d.Id())
 	if err != nil {
-		return fmt.Errorf("Error deleting pipeline: %s", err)
+		return diag.Errorf("Error deleting pipeline: %s", err)
 	}
 
 	d.SetId("")
@@ -161,12 +164,12 @@ func resourceHerokuPipelineDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func resourceHerokuPipelineRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
-	p, err := client.PipelineInfo(context.TODO(), d.Id())
+	p, err := client.PipelineInfo(ctx, d.Id())
 	if err != nil {
-		return fmt.Errorf("Error retrieving pipeline: %s", err)
+		return diag.Errorf("Error retrieving pipeline: %s", err)
 	}
 
 	setPipelineAttributes(d, p)
@@ -182,3 +185,24 @@ func setPipelineAttributes(d *schema.ResourceData, p *heroku.Pipeline) {
 	ownerInfo["type"] = p.Owner.Type
 	d.Set("owner", []interface{}{ownerInfo})
 }
+
+// resourceHerokuPipelineCustomizeDiff plans the provider's default team as the
+// owner of a pipeline which does not set one.
+func resourceHerokuPipelineCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	config := v.(*Config)
+	if config.DefaultTeam == "" || !isUnsetInConfig(diff, "owner") {
+		return nil
+	}
+
+	team, err := config.Api.TeamInfo(ctx, config.DefaultTeam)
+	if err != nil {
+		return fmt.Errorf("Error retrieving default team %s: %s", config.DefaultTeam, err)
+	}
+
+	old, _ := diff.GetChange("owner")
+	if oldL := old.([]interface{}); len(oldL) > 0 && oldL[0] != nil && oldL[0].(map[string]interface{})["id"] == team.ID {
+		return nil
+	}
+
+	return diff.SetNew("owner", []interface{}{map[string]interface{}{"id": team.ID, "type": "team"}})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	heroku "github.com/heroku/heroku-go/v6"
@@ -12,13 +13,13 @@ import (
 
 func resourceHerokuPipelineConfigVar() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuPipelineConfigVarCreate,
-		Update: resourceHerokuPipelineConfigVarUpdate,
-		Read:   resourceHerokuPipelineConfigVarRead,
-		Delete: resourceHerokuPipelineConfigVarDelete,
+		CreateContext: resourceHerokuPipelineConfigVarCreate,
+		UpdateContext: resourceHerokuPipelineConfigVarUpdate,
+		ReadContext:   resourceHerokuPipelineConfigVarRead,
+		DeleteContext: resourceHerokuPipelineConfigVarDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuPipelineConfigVarImport,
+			StateContext: resourceHerokuPipelineConfigVarImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -65,13 +66,13 @@ func resourceHerokuPipelineConfigVar() *schema.Resource {
 	}
 }
 
-func resourceHerokuPipelineConfigVarImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuPipelineConfigVarImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	noImportErr := fmt.Errorf("not possible to import this resource")
 
 	return nil, noImportErr
 }
 
-func resourceHerokuPipelineConfigVarCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelineConfigVarCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	pipelineID := getPipelineID(d)
@@ -82,7 +83,7 @@ func resourceHerokuPipelineConfigVarCreate(d *schema.ResourceData, meta interfac
 	// Check for duplicates between vars & sensitiveVars
 	dupeErr := duplicateVarsChecker(vars, sensitiveVars)
 	if dupeErr != nil {
-		return dupeErr
+		return diag.FromErr(dupeErr)
 	}
 
 	// Combine both sensitive and non-sensitive vars
@@ -91,9 +92,9 @@ func resourceHerokuPipelineConfigVarCreate(d *schema.ResourceData, meta interfac
 	log.Printf("[INFO] Creating pipeline [%s] stage [%s] config vars", pipelineID, pipelineStage)
 
 	// Update the vars for the pipeline
-	updateErr := updatePipelineConfigVars(client, pipelineID, pipelineStage, nil, combinedVars)
+	updateErr := updatePipelineConfigVars(ctx, client, pipelineID, pipelineStage, nil, combinedVars)
 	if updateErr != nil {
-		return updateErr
+		return diag.FromErr(updateErr)
 	}
 
 	log.Printf("[INFO] Created pipeline [%s] stage [%s] config vars", pipelineID, pipelineStage)
@@ -101,10 +102,10 @@ func resourceHerokuPipelineConfigVarCreate(d *schema.ResourceData, meta interfac
 	// Set the ID to be pipeline ID + stage
 	d.SetId(fmt.Sprintf("%s:%s", pipelineID, pipelineStage))
 
-	return resourceHerokuPipelineConfigVarRead(d, meta)
+	return resourceHerokuPipelineConfigVarRead(ctx, d, meta)
 }
 
-func resourceHerokuPipelineConfigVarUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelineConfigVarUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	pipelineID := getPipelineID(d)
 	pipelineStage := getPipelineStage(d)
@@ -120,28 +121,28 @@ func resourceHerokuPipelineConfigVarUpdate(d *schema.ResourceData, meta interfac
 	log.Printf("[INFO] Updating pipeline [%s] stage [%s] config vars", pipelineID, pipelineStage)
 
 	// Update the vars for the pipeline
-	updateErr := updatePipelineConfigVars(client, pipelineID, pipelineStage, allOldVars, allNewVars)
+	updateErr := updatePipelineConfigVars(ctx, client, pipelineID, pipelineStage, allOldVars, allNewVars)
 	if updateErr != nil {
-		return updateErr
+		return diag.FromErr(updateErr)
 	}
 
 	log.Printf("[INFO] Updated pipeline [%s] stage [%s] config vars", pipelineID, pipelineStage)
 
-	return resourceHerokuPipelineConfigVarRead(d, meta)
+	return resourceHerokuPipelineConfigVarRead(ctx, d, meta)
 }
 
-func resourceHerokuPipelineConfigVarRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelineConfigVarRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	// Parse the resource ID to return the pipeline ID & stage
 	pipelineID, pipelineStage, parseErr := parseCompositeID(d.Id())
 	if parseErr != nil {
-		return parseErr
+		return diag.FromErr(parseErr)
 	}
 
-	remotePipelineVars, getErr := client.PipelineConfigVarInfoForApp(context.TODO(), pipelineID, pipelineStage)
+	remotePipelineVars, getErr := client.PipelineConfigVarInfoForApp(ctx, pipelineID, pipelineStage)
 	if getErr != nil {
-		return getErr
+		return diag.FromErr(getErr)
 	}
 
 	// Need to convert remotePipelineVars to a data type required by vetVarsForState
@@ -152,8 +153,8 @@ func resourceHerokuPipelineConfigVarRead(d *schema.ResourceData, meta interface{
 
 	vettedConfigVars, vettedSensitiveConfigVars := vetVarsForState(getVars(d), getSensitiveVars(d), rpvFormatted)
 
-	log.Printf("[DEBUG] pipeline config vars to be set in state: *%#v", vettedConfigVars)
-	log.Printf("[DEBUG] pipeline sensitive config vars to be set in state: *%#v", vettedSensitiveConfigVars)
+	log.Printf("[DEBUG] pipeline config vars to be set in state: %s", logKeys(vettedConfigVars))
+	log.Printf("[DEBUG] pipeline sensitive config vars to be set in state: %s", logKeys(vettedSensitiveConfigVars))
 
 	var setErr error
 	setErr = d.Set("pipeline_id", pipelineID)
@@ -162,10 +163,10 @@ func resourceHerokuPipelineConfigVarRead(d *schema.ResourceData, meta interface{
 	setErr = d.Set("sensitive_vars", vettedSensitiveConfigVars)
 	setErr = d.Set("all_vars", rpvFormatted)
 
-	return setErr
+	return diag.FromErr(setErr)
 }
 
-func resourceHerokuPipelineConfigVarDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelineConfigVarDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	pipelineID := getPipelineID(d)
 	pipelineStage := getPipelineStage(d)
@@ -177,9 +178,9 @@ func resourceHerokuPipelineConfigVarDelete(d *schema.ResourceData, meta interfac
 	log.Printf("[INFO] Removing pipeline [%s] stage [%s] config vars", pipelineID, pipelineStage)
 
 	// Delete all config vars by setting the vars defined in resource schema to nil value.
-	updateErr := updatePipelineConfigVars(client, pipelineID, pipelineStage, allVars, nil)
+	updateErr := updatePipelineConfigVars(ctx, client, pipelineID, pipelineStage, allVars, nil)
 	if updateErr != nil {
-		return updateErr
+		return diag.FromErr(updateErr)
 	}
 
 	log.Printf("[INFO] Removed pipeline [%s] stage [%s] config vars", pipelineID, pipelineStage)
@@ -187,17 +188,17 @@ func resourceHerokuPipelineConfigVarDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func updatePipelineConfigVars(client *heroku.Service, pipelineID, pipelineStage string,
+func updatePipelineConfigVars(ctx context.Context, client *heroku.Service, pipelineID, pipelineStage string,
 	oldVars, newVars map[string]interface{}) error {
 	varsToModify := constructVars(oldVars, newVars)
 
-	log.Printf("[INFO] Modifying pipeline [%s] stage [%s] config vars: *%#v", pipelineID, pipelineStage, varsToModify)
+	log.Printf("[INFO] Modifying pipeline [%s] stage [%s] config vars: %s", pipelineID, pipelineStage, logKeys(varsToModify))
 
-	if _, updateErr := client.PipelineConfigVarUpdate(context.TODO(), pipelineID, pipelineStage, varsToModify); updateErr != nil {
+	if _, updateErr := client.PipelineConfigVarUpdate(ctx, pipelineID, pipelineStage, varsToModify); updateErr != nil {
 		return fmt.Errorf("error updating pipeline config vars: %s", updateErr)
 	}
 
-	log.Printf("[INFO] Modifying pipeline [%s] stage [%s] config vars: *%#v", pipelineID, pipelineStage, varsToModify)
+	log.Printf("[INFO] Modifying pipeline [%s] stage [%s] config vars: %s", pipelineID, pipelineStage, logKeys(varsToModify))
 
 	return nil
 }
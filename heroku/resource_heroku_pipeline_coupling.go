@@ -2,10 +2,10 @@ package heroku
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	heroku "github.com/heroku/heroku-go/v6"
@@ -13,12 +13,12 @@ import (
 
 func resourceHerokuPipelineCoupling() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuPipelineCouplingCreate,
-		Read:   resourceHerokuPipelineCouplingRead,
-		Delete: resourceHerokuPipelineCouplingDelete,
+		CreateContext: resourceHerokuPipelineCouplingCreate,
+		ReadContext:   resourceHerokuPipelineCouplingRead,
+		DeleteContext: resourceHerokuPipelineCouplingDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -55,7 +55,7 @@ func resourceHerokuPipelineCoupling() *schema.Resource {
 	}
 }
 
-func resourceHerokuPipelineCouplingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelineCouplingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	opts := heroku.PipelineCouplingCreateOpts{
@@ -66,45 +66,45 @@ func resourceHerokuPipelineCouplingCreate(d *schema.ResourceData, meta interface
 
 	log.Printf("[DEBUG] PipelineCoupling create configuration: %#v", opts)
 
-	p, err := client.PipelineCouplingCreate(context.TODO(), opts)
+	p, err := client.PipelineCouplingCreate(ctx, opts)
 	if err != nil {
 		// Enhance generation-related errors with app context
 		errMsg := err.Error()
 		if strings.Contains(errMsg, "same generation") {
-			if app, appErr := client.AppInfo(context.TODO(), d.Get("app_id").(string)); appErr == nil {
-				return fmt.Errorf("%s\n\nYour app '%s' is %s generation. Ensure all apps in the pipeline use the same generation (Cedar or Fir)",
+			if app, appErr := client.AppInfo(ctx, d.Get("app_id").(string)); appErr == nil {
+				return diag.Errorf("%s\n\nYour app '%s' is %s generation. Ensure all apps in the pipeline use the same generation (Cedar or Fir)",
 					errMsg, app.Name, app.Generation.Name)
 			}
 		}
-		return fmt.Errorf("error creating pipeline: %s", err)
+		return diag.Errorf("error creating pipeline: %s", err)
 	}
 
 	d.SetId(p.ID)
 
 	log.Printf("[INFO] PipelineCoupling ID: %s", d.Id())
 
-	return resourceHerokuPipelineCouplingRead(d, meta)
+	return resourceHerokuPipelineCouplingRead(ctx, d, meta)
 }
 
-func resourceHerokuPipelineCouplingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelineCouplingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	log.Printf("[INFO] Deleting pipeline: %s", d.Id())
 
-	_, err := client.PipelineCouplingDelete(context.TODO(), d.Id())
+	_, err := client.PipelineCouplingDelete(ctx, d.Id())
 	if err != nil {
-		return fmt.Errorf("error deleting pipeline: %s", err)
+		return diag.Errorf("error deleting pipeline: %s", err)
 	}
 
 	return nil
 }
 
-func resourceHerokuPipelineCouplingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelineCouplingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
-	p, err := client.PipelineCouplingInfo(context.TODO(), d.Id())
+	p, err := client.PipelineCouplingInfo(ctx, d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving pipeline: %s", err)
+		return diag.Errorf("error retrieving pipeline: %s", err)
 	}
 
 	d.Set("app_id", p.App.ID)
@@ -6,9 +6,9 @@ package heroku
 
 import (
 	"context"
-	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	heroku "github.com/heroku/heroku-go/v6"
@@ -16,9 +16,9 @@ import (
 
 func resourceHerokuPipelinePromotion() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuPipelinePromotionCreate,
-		Read:   resourceHerokuPipelinePromotionRead,
-		Delete: resourceHerokuPipelinePromotionDelete,
+		CreateContext: resourceHerokuPipelinePromotionCreate,
+		ReadContext:   resourceHerokuPipelinePromotionRead,
+		DeleteContext: resourceHerokuPipelinePromotionDelete,
 
 		Schema: map[string]*schema.Schema{
 			"pipeline": {
@@ -99,7 +99,7 @@ func resourceHerokuPipelinePromotion() *schema.Resource {
 	}
 }
 
-func resourceHerokuPipelinePromotionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelinePromotionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	log.Printf("[DEBUG] Creating pipeline promotion")
@@ -138,25 +138,25 @@ func resourceHerokuPipelinePromotionCreate(d *schema.ResourceData, meta interfac
 
 	log.Printf("[DEBUG] Pipeline promotion create configuration: %#v", opts)
 
-	promotion, err := client.PipelinePromotionCreate(context.TODO(), opts)
+	promotion, err := client.PipelinePromotionCreate(ctx, opts)
 	if err != nil {
-		return fmt.Errorf("error creating pipeline promotion: %s", err)
+		return diag.Errorf("error creating pipeline promotion: %s", err)
 	}
 
 	log.Printf("[INFO] Created pipeline promotion ID: %s", promotion.ID)
 	d.SetId(promotion.ID)
 
-	return resourceHerokuPipelinePromotionRead(d, meta)
+	return resourceHerokuPipelinePromotionRead(ctx, d, meta)
 }
 
-func resourceHerokuPipelinePromotionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelinePromotionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	log.Printf("[DEBUG] Reading pipeline promotion: %s", d.Id())
 
-	promotion, err := client.PipelinePromotionInfo(context.TODO(), d.Id())
+	promotion, err := client.PipelinePromotionInfo(ctx, d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving pipeline promotion: %s", err)
+		return diag.Errorf("error retrieving pipeline promotion: %s", err)
 	}
 
 	// Set computed fields
@@ -168,9 +168,9 @@ func resourceHerokuPipelinePromotionRead(d *schema.ResourceData, meta interface{
 	d.Set("source_app_id", promotion.Source.App.ID)
 
 	// Fetch promotion targets to get the resulting release IDs
-	targets, err := client.PipelinePromotionTargetList(context.TODO(), d.Id(), nil)
+	targets, err := client.PipelinePromotionTargetList(ctx, d.Id(), nil)
 	if err != nil {
-		return fmt.Errorf("error retrieving pipeline promotion targets: %s", err)
+		return diag.Errorf("error retrieving pipeline promotion targets: %s", err)
 	}
 
 	// Build list of promoted releases with app associations
@@ -195,7 +195,7 @@ func resourceHerokuPipelinePromotionRead(d *schema.ResourceData, meta interface{
 
 	// Set promoted_release_ids (new structured attribute)
 	if err := d.Set("promoted_release_ids", promotedReleases); err != nil {
-		return fmt.Errorf("error setting promoted_release_ids: %s", err)
+		return diag.Errorf("error setting promoted_release_ids: %s", err)
 	}
 
 	// Set promoted_release_id (deprecated, for backwards compatibility)
@@ -208,7 +208,7 @@ func resourceHerokuPipelinePromotionRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceHerokuPipelinePromotionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuPipelinePromotionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] There is no DELETE for pipeline promotion resource so this is a no-op. Promotion will be removed from state.")
 	return nil
 }
@@ -0,0 +1,272 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+// The states of a review app, from its creation to its deletion.
+const (
+	reviewAppStatusPending  = "pending"
+	reviewAppStatusCreating = "creating"
+	reviewAppStatusCreated  = "created"
+	reviewAppStatusDeleting = "deleting"
+	reviewAppStatusDeleted  = "deleted"
+	reviewAppStatusErrored  = "errored"
+)
+
+func resourceHerokuReviewApp() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuReviewAppCreate,
+		ReadContext:   resourceHerokuReviewAppRead,
+		DeleteContext: resourceHerokuReviewAppDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"pipeline_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"branch": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"source_blob": {
+				Type:             schema.TypeList,
+				Required:         true,
+				ForceNew:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressReviewAppImportDiff,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     validateSourceUrl,
+							DiffSuppressFunc: suppressReviewAppImportDiff,
+						},
+
+						"version": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: suppressReviewAppImportDiff,
+						},
+					},
+				},
+			},
+
+			"environment": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressReviewAppImportDiff,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+	}
+}
+
+func resourceHerokuReviewAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	opts := heroku.ReviewAppCreateOpts{
+		Pipeline: d.Get("pipeline_id").(string),
+		Branch:   d.Get("branch").(string),
+	}
+
+	if v, ok := d.GetOk("source_blob"); ok {
+		for _, s := range v.([]interface{}) {
+			blob := s.(map[string]interface{})
+			url := blob["url"].(string)
+			opts.SourceBlob.URL = &url
+			if version := blob["version"].(string); version != "" {
+				opts.SourceBlob.Version = &version
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("environment"); ok {
+		opts.Environment = make(map[string]*string)
+		for k, val := range v.(map[string]interface{}) {
+			s := val.(string)
+			opts.Environment[k] = &s
+		}
+	}
+
+	log.Printf("[DEBUG] Creating review app of pipeline %s for branch %s, environment %s", opts.Pipeline, opts.Branch, logKeys(opts.Environment))
+	reviewApp, err := client.ReviewAppCreate(ctx, opts)
+	if err != nil {
+		return diag.Errorf("Error creating review app of pipeline %s for branch %s: %s", opts.Pipeline, opts.Branch, err)
+	}
+
+	// Track the review app, even if it fails, so that it is torn down.
+	d.SetId(reviewApp.ID)
+	log.Printf("[INFO] Review app ID: %s", d.Id())
+
+	log.Printf("[DEBUG] Waiting for review app (%s) to be created", reviewApp.ID)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{reviewAppStatusPending, reviewAppStatusCreating},
+		Target:  []string{reviewAppStatusCreated},
+		Refresh: failOnReviewAppError(ReviewAppStateRefreshFunc(ctx, client, reviewApp.ID)),
+		Timeout: d.Timeout(schema.TimeoutCreate),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		// Record the app and message of the failed review app.
+		diags := diag.Errorf("Error waiting for review app (%s) to be created: %s", reviewApp.ID, err)
+		return append(diags, resourceHerokuReviewAppRead(ctx, d, meta)...)
+	}
+
+	return resourceHerokuReviewAppRead(ctx, d, meta)
+}
+
+func resourceHerokuReviewAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	reviewApp, err := client.ReviewAppGetReviewApp(ctx, d.Id())
+	if isNotFoundError(err) || (err == nil && reviewApp.Status == reviewAppStatusDeleted) {
+		logWarn(ctx, fmt.Sprintf("Review app %s no longer exists, removing it from state", d.Id()))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("Error retrieving review app: %s", err)
+	}
+
+	d.Set("pipeline_id", reviewApp.Pipeline.ID)
+	d.Set("branch", reviewApp.Branch)
+	d.Set("status", reviewApp.Status)
+
+	if reviewApp.App != nil {
+		d.Set("app_id", reviewApp.App.ID)
+	}
+	if reviewApp.Message != nil {
+		d.Set("message", *reviewApp.Message)
+	}
+
+	return nil
+}
+
+func resourceHerokuReviewAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	log.Printf("[INFO] Deleting review app: %s", d.Id())
+	if _, err := client.ReviewAppDelete(ctx, d.Id()); err != nil {
+		if isNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error deleting review app: %s", err)
+	}
+
+	log.Printf("[DEBUG] Waiting for review app (%s) to be deleted", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{reviewAppStatusPending, reviewAppStatusCreating, reviewAppStatusCreated, reviewAppStatusErrored, reviewAppStatusDeleting},
+		Target:  []string{reviewAppStatusDeleted},
+		Refresh: ReviewAppStateRefreshFunc(ctx, client, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for review app (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// suppressReviewAppImportDiff suppresses the diffs of source_blob and
+// environment while source_blob is missing from state, which only happens
+// once a review app is imported, as the Platform API does not return them.
+// Otherwise, the plan following an import would replace the review app.
+func suppressReviewAppImportDiff(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+	recorded, _ := d.GetChange("source_blob")
+	return len(recorded.([]interface{})) == 0
+}
+
+// Returns a resource.StateRefreshFunc that is used to watch a review app. A
+// review app which is no longer found is taken to be deleted.
+func ReviewAppStateRefreshFunc(ctx context.Context, client *heroku.Service, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		reviewApp, err := client.ReviewAppGetReviewApp(ctx, id)
+		if isNotFoundError(err) {
+			return id, reviewAppStatusDeleted, nil
+		}
+		if err != nil {
+			log.Printf("[DEBUG] Failed to get review app status: %s (review app: %s)", err, id)
+			return nil, "", err
+		}
+
+		return reviewApp, reviewApp.Status, nil
+	}
+}
+
+// failOnReviewAppError fails a wait when the review app errors. Errored review
+// apps may still be deleted, so only creation fails on them.
+func failOnReviewAppError(refresh resource.StateRefreshFunc) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, status, err := refresh()
+		if err == nil && status == reviewAppStatusErrored {
+			return nil, "", reviewAppError(v.(*heroku.ReviewApp))
+		}
+		return v, status, err
+	}
+}
+
+// reviewAppError describes why a review app could not be created.
+func reviewAppError(reviewApp *heroku.ReviewApp) error {
+	msg := fmt.Sprintf("Review app %s errored", reviewApp.ID)
+	if reviewApp.ErrorStatus != nil && *reviewApp.ErrorStatus != "" {
+		msg += fmt.Sprintf(" (%s)", *reviewApp.ErrorStatus)
+	}
+	if reviewApp.Message != nil && *reviewApp.Message != "" {
+		msg += ": " + *reviewApp.Message
+	}
+	return fmt.Errorf("%s", msg)
+}
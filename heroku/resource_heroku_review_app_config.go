@@ -173,7 +173,7 @@ func resourceHerokuReviewAppConfigCreate(ctx context.Context, d *schema.Resource
 
 	if v, ok := d.GetOk("base_name"); ok {
 		vs := v.(string)
-		if IsFeatureSupported(pipeline.Generation.Name, "pipeline", "base_name") {
+		if meta.(*Config).IsFeatureSupported(ctx, pipeline.Generation.Name, "pipeline", "base_name") {
 			log.Printf("[DEBUG] review app enable - base_name: %s", vs)
 			opts.BaseName = &vs
 		} else {
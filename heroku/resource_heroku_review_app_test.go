@@ -0,0 +1,84 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccHerokuReviewApp_Basic(t *testing.T) {
+	pipelineID := testAccConfig.GetPipelineIDorSkip(t)
+	branch := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHerokuReviewAppDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuReviewApp_basic(pipelineID, branch),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_review_app.foobar", "status", "created"),
+					resource.TestCheckResourceAttr(
+						"heroku_review_app.foobar", "branch", branch),
+					resource.TestCheckResourceAttrSet(
+						"heroku_review_app.foobar", "app_id"),
+				),
+			},
+			{
+				ResourceName:            "heroku_review_app.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_blob", "environment"},
+				ImportStatePersist:      true,
+			},
+			{
+				// The imported review app is not replaced.
+				Config:   testAccCheckHerokuReviewApp_basic(pipelineID, branch),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuReviewAppDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).Api
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "heroku_review_app" {
+			continue
+		}
+
+		reviewApp, err := client.ReviewAppGetReviewApp(context.TODO(), rs.Primary.ID)
+		if err == nil && reviewApp.Status != reviewAppStatusDeleted {
+			return fmt.Errorf("Review app %s still exists (%s)", rs.Primary.ID, reviewApp.Status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckHerokuReviewApp_basic(pipelineID, branch string) string {
+	return fmt.Sprintf(`
+resource "heroku_review_app" "foobar" {
+	pipeline_id = "%s"
+	branch      = "%s"
+
+	source_blob {
+		url     = "https://github.com/heroku/terraform-provider-heroku/raw/update-heroku-api-client/heroku/test-fixtures/app.tgz"
+		version = "v1"
+	}
+
+	environment = {
+		GREETING = "hello"
+	}
+}
+`, pipelineID, branch)
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,13 +23,13 @@ import (
 
 func resourceHerokuSlug() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourceHerokuSlugCreate,
-		Read:          resourceHerokuSlugRead,
-		Delete:        resourceHerokuSlugDelete,
+		CreateContext: resourceHerokuSlugCreate,
+		ReadContext:   resourceHerokuSlugRead,
+		DeleteContext: resourceHerokuSlugDelete,
 		CustomizeDiff: resourceHerokuSlugCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuSlugImport,
+			StateContext: resourceHerokuSlugImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -131,10 +132,14 @@ func resourceHerokuSlug() *schema.Resource {
 				Version: 0,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
 	}
 }
 
-func resourceHerokuSlugImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuSlugImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*Config).Api
 
 	app, slugID, err := parseCompositeID(d.Id())
@@ -142,14 +147,14 @@ func resourceHerokuSlugImport(d *schema.ResourceData, meta interface{}) ([]*sche
 		return nil, err
 	}
 
-	slug, err := client.SlugInfo(context.Background(), app, slugID)
+	slug, err := client.SlugInfo(ctx, app, slugID)
 	if err != nil {
 		return nil, err
 	}
 
 	d.SetId(slug.ID)
 
-	foundApp, err := resourceHerokuAppRetrieve(app, client)
+	foundApp, err := resourceHerokuAppRetrieve(ctx, app, client)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +169,7 @@ func resourceHerokuSlugImport(d *schema.ResourceData, meta interface{}) ([]*sche
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceHerokuSlugCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSlugCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	appID := getAppId(d)
@@ -197,13 +202,13 @@ func resourceHerokuSlugCreate(d *schema.ResourceData, meta interface{}) error {
 
 		newUuid, err := uuid.GenerateUUID()
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		filePath = fmt.Sprintf("slug-%s.tgz", newUuid)
 
-		err = downloadSlug(fileUrl, filePath)
+		err = downloadSlug(ctx, meta.(*Config).blobClient(), fileUrl, filePath)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 		defer cleanupSlugFile(filePath)
 	}
@@ -211,7 +216,7 @@ func resourceHerokuSlugCreate(d *schema.ResourceData, meta interface{}) error {
 	// Require a file path by validating this programmatically.
 	// (ConflictsWith cannot be used with Required)
 	if filePath == "" {
-		return fmt.Errorf("Error creating slug: requires either `file_path` or `file_url` attribute")
+		return diag.Errorf("Error creating slug: requires either `file_path` or `file_url` attribute")
 	}
 
 	if v, ok := d.GetOk("checksum"); ok {
@@ -222,7 +227,7 @@ func resourceHerokuSlugCreate(d *schema.ResourceData, meta interface{}) error {
 		if filePath != "" {
 			checksum, err := checksumSlug(filePath)
 			if err != nil {
-				return err
+				return diag.FromErr(err)
 			}
 			log.Printf("[DEBUG] Slug checksum: %s", checksum)
 			opts.Checksum = heroku.String(checksum)
@@ -239,27 +244,31 @@ func resourceHerokuSlugCreate(d *schema.ResourceData, meta interface{}) error {
 		opts.Stack = heroku.String(v.(string))
 	}
 
-	slug, err := client.SlugCreate(context.TODO(), appID, opts)
+	slug, err := client.SlugCreate(ctx, appID, opts)
 	if err != nil {
-		return fmt.Errorf("Error creating slug: %s opts %+v", err, opts)
+		return diag.Errorf("Error creating slug: %s opts %+v", err, opts)
 	}
 
 	// Optionally upload slug before setting ID, so that an upload failure
 	// causes a resource creation error, is not saved in state.
 	if filePath != "" {
 		var uploadErr error
-		retryErr := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			uploadErr = uploadSlug(filePath, slug.Blob.Method, slug.Blob.URL)
+		retryErr := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			uploadErr = uploadSlug(ctx, meta.(*Config).blobClient(), filePath, slug.Blob.Method, slug.Blob.URL)
 			if uploadErr != nil {
 				log.Printf("[DEBUG] Error uploading slug: %s", uploadErr.Error())
 				log.Printf("[DEBUG] Retry uploading slug")
-				time.Sleep(10 * time.Second)
+				select {
+				case <-ctx.Done():
+					return resource.NonRetryableError(ctx.Err())
+				case <-time.After(10 * time.Second):
+				}
 				return resource.RetryableError(uploadErr)
 			}
 			return nil
 		})
 		if retryErr != nil {
-			return fmt.Errorf("Error uploading slug: %s, last error: %s", retryErr, uploadErr)
+			return diag.Errorf("Error uploading slug: %s, last error: %s", retryErr, uploadErr)
 		}
 	}
 
@@ -267,32 +276,32 @@ func resourceHerokuSlugCreate(d *schema.ResourceData, meta interface{}) error {
 
 	setErr := setSlugState(d, slug)
 	if setErr != nil {
-		return setErr
+		return diag.FromErr(setErr)
 	}
 
 	log.Printf("[INFO] Created slug ID: %s", d.Id())
 	return nil
 }
 
-func resourceHerokuSlugRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSlugRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	appID := getAppId(d)
-	slug, err := client.SlugInfo(context.TODO(), appID, d.Id())
+	slug, err := client.SlugInfo(ctx, appID, d.Id())
 	if err != nil {
-		return fmt.Errorf("Error retrieving slug: %s", err)
+		return diag.Errorf("Error retrieving slug: %s", err)
 	}
 
 	setErr := setSlugState(d, slug)
 	if setErr != nil {
-		return setErr
+		return diag.FromErr(setErr)
 	}
 
 	return nil
 }
 
 // A no-op method as there is no DELETE slug in Heroku Platform API.
-func resourceHerokuSlugDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSlugDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[INFO] There is no DELETE for slug resource so this is a no-op. Slug will be removed from state.")
 	return nil
 }
@@ -319,11 +328,10 @@ func resourceHerokuSlugCustomizeDiff(ctx context.Context, diff *schema.ResourceD
 	return nil
 }
 
-func downloadSlug(httpUrl, destinationFilePath string) error {
+func downloadSlug(ctx context.Context, httpClient *http.Client, httpUrl, destinationFilePath string) error {
 	log.Printf("[DEBUG] Downloading slug from %s", httpUrl)
 
-	httpClient := &http.Client{}
-	req, err := http.NewRequest("GET", httpUrl, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", httpUrl, nil)
 	if err != nil {
 		return fmt.Errorf("Error creating slug download request: %s (%s)", err, httpUrl)
 	}
@@ -358,7 +366,7 @@ func downloadSlug(httpUrl, destinationFilePath string) error {
 	return nil
 }
 
-func uploadSlug(filePath, httpMethod, httpUrl string) error {
+func uploadSlug(ctx context.Context, httpClient *http.Client, filePath, httpMethod, httpUrl string) error {
 	method := strings.ToUpper(httpMethod)
 	log.Printf("[DEBUG] Uploading slug '%s' to %s %s", filePath, method, httpUrl)
 
@@ -372,8 +380,7 @@ func uploadSlug(filePath, httpMethod, httpUrl string) error {
 	}
 	defer file.Close()
 
-	httpClient := &http.Client{}
-	req, err := http.NewRequest(method, httpUrl, file)
+	req, err := http.NewRequestWithContext(ctx, method, httpUrl, file)
 	if err != nil {
 		return fmt.Errorf("Error creating slug upload request: %s", err)
 	}
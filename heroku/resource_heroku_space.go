@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -20,14 +21,14 @@ type spaceWithNAT struct {
 
 func resourceHerokuSpace() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourceHerokuSpaceCreate,
-		Read:          resourceHerokuSpaceRead,
-		Update:        resourceHerokuSpaceUpdate,
-		Delete:        resourceHerokuSpaceDelete,
+		CreateContext: resourceHerokuSpaceCreate,
+		ReadContext:   resourceHerokuSpaceRead,
+		UpdateContext: resourceHerokuSpaceUpdate,
+		DeleteContext: resourceHerokuSpaceDelete,
 		CustomizeDiff: resourceHerokuSpaceCustomizeDiff,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -38,7 +39,8 @@ func resourceHerokuSpace() *schema.Resource {
 
 			"organization": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -67,6 +69,7 @@ func resourceHerokuSpace() *schema.Resource {
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -80,16 +83,20 @@ func resourceHerokuSpace() *schema.Resource {
 			"generation": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      "cedar",
+				Computed:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"cedar", "fir"}, false),
-				Description:  "Generation of the space. Defaults to cedar for backward compatibility.",
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Generation of the space. Defaults to the provider's default generation, or cedar for backward compatibility.",
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
 	}
 }
 
-func resourceHerokuSpaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	opts := heroku.SpaceCreateOpts{}
@@ -125,9 +132,9 @@ func resourceHerokuSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Creating space with generation: %s", vs)
 	}
 
-	space, err := client.SpaceCreate(context.TODO(), opts)
+	space, err := client.SpaceCreate(ctx, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(space.ID)
@@ -138,26 +145,28 @@ func resourceHerokuSpaceCreate(d *schema.ResourceData, meta interface{}) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"allocating"},
 		Target:  []string{"allocated"},
-		Refresh: SpaceStateRefreshFunc(client, d.Id()),
-		Timeout: 20 * time.Minute,
+		Refresh: SpaceStateRefreshFunc(ctx, client, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Space (%s) to become available: %s", d.Id(), err)
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for Space (%s) to become available: %s", d.Id(), err)
 	}
 
 	config := meta.(*Config)
-	time.Sleep(time.Duration(config.PostSpaceCreateDelay) * time.Second)
+	waitForReadiness(ctx, fmt.Sprintf("Space (%s)", d.Id()),
+		spaceReadyStateRefreshFunc(ctx, client, opts.Name),
+		time.Duration(config.PostSpaceCreateDelay)*time.Second)
 
-	return resourceHerokuSpaceRead(d, meta)
+	return resourceHerokuSpaceRead(ctx, d, meta)
 }
 
-func resourceHerokuSpaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
-	spaceRaw, _, err := SpaceStateRefreshFunc(client, d.Id())()
+	spaceRaw, _, err := SpaceStateRefreshFunc(ctx, client, d.Id())()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	space := spaceRaw.(*spaceWithNAT)
@@ -178,7 +187,7 @@ func resourceHerokuSpaceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	generationStr := generation.(string)
 	if space.Shield && !IsFeatureSupported(generationStr, "space", "shield") {
-		tflog.Warn(context.TODO(), fmt.Sprintf("Space has `shield` set to `true` but Shield spaces are unsupported for the %s generation", generationStr))
+		tflog.Warn(ctx, fmt.Sprintf("Space has `shield` set to `true` but Shield spaces are unsupported for the %s generation", generationStr))
 	}
 
 	log.Printf("[DEBUG] Set NAT source IPs to %s for %s", space.NAT.Sources, d.Id())
@@ -186,29 +195,29 @@ func resourceHerokuSpaceRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceHerokuSpaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	if d.HasChange("name") {
 		name := d.Get("name").(string)
 		opts := heroku.SpaceUpdateOpts{Name: &name}
 
-		_, err := client.SpaceUpdate(context.TODO(), d.Id(), opts)
+		_, err := client.SpaceUpdate(ctx, d.Id(), opts)
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
 	return nil
 }
 
-func resourceHerokuSpaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	log.Printf("[INFO] Deleting space: %s", d.Id())
-	_, err := client.SpaceDelete(context.TODO(), d.Id())
+	_, err := client.SpaceDelete(ctx, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -217,9 +226,9 @@ func resourceHerokuSpaceDelete(d *schema.ResourceData, meta interface{}) error {
 
 // SpaceStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // a Space.
-func SpaceStateRefreshFunc(client *heroku.Service, id string) resource.StateRefreshFunc {
+func SpaceStateRefreshFunc(ctx context.Context, client *heroku.Service, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		space, err := client.SpaceInfo(context.TODO(), id)
+		space, err := client.SpaceInfo(ctx, id)
 		if err != nil {
 			log.Printf("[DEBUG] %s (%s)", err, id)
 			return nil, "", err
@@ -234,7 +243,7 @@ func SpaceStateRefreshFunc(client *heroku.Service, id string) resource.StateRefr
 			return &s, space.State, nil
 		}
 
-		nat, err := client.SpaceNATInfo(context.TODO(), id)
+		nat, err := client.SpaceNATInfo(ctx, id)
 		if err != nil {
 			return nil, "", err
 		}
@@ -246,21 +255,86 @@ func SpaceStateRefreshFunc(client *heroku.Service, id string) resource.StateRefr
 	}
 }
 
+// spaceReadyStateRefreshFunc reports a Space as ready once it can be looked up
+// as allocated by name, which is how apps and other resources refer to it.
+func spaceReadyStateRefreshFunc(ctx context.Context, client *heroku.Service, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		space, err := client.SpaceInfo(ctx, name)
+		if err != nil {
+			log.Printf("[DEBUG] Space (%s) not yet readable: %s", name, err)
+			return name, "pending", nil
+		}
+
+		if space.State != "allocated" {
+			log.Printf("[DEBUG] Space (%s) not yet allocated: %s", name, space.State)
+			return space, "pending", nil
+		}
+
+		return space, "ready", nil
+	}
+}
+
 // resourceHerokuSpaceCustomizeDiff validates generation-specific feature support during plan phase
 func resourceHerokuSpaceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if err := resolveSpaceDefaults(diff, v.(*Config)); err != nil {
+		return err
+	}
+
 	generation, generationExists := diff.GetOk("generation")
 	shield, shieldExists := diff.GetOk("shield")
 
+	if generationExists && diff.HasChange("generation") {
+		config := v.(*Config)
+
+		generationStr := generation.(string)
+		if !config.isKnownGeneration(ctx, generationStr) {
+			return fmt.Errorf("generation %q is not available, must be one of %v", generationStr, config.knownGenerations(ctx))
+		}
+		if !config.canValidateFeatures(ctx, generationStr) {
+			logWarn(ctx, fmt.Sprintf("Generation %q is not known to this provider version, so its feature support cannot be validated", generationStr))
+		}
+	}
+
 	// Only validate if both fields are present
 	if generationExists && shieldExists {
 		generationStr := generation.(string)
 		shieldBool := shield.(bool)
 
 		// Check if shield is enabled for a generation that doesn't support it
-		if shieldBool && !IsFeatureSupported(generationStr, "space", "shield") {
+		config := v.(*Config)
+		if shieldBool && config.canValidateFeatures(ctx, generationStr) && !config.IsFeatureSupported(ctx, generationStr, "space", "shield") {
 			return fmt.Errorf("shield spaces are not supported for %s generation", generationStr)
 		}
 	}
 
 	return nil
 }
+
+// spaceGeneration is the generation of a space which does not set one.
+func spaceGeneration(config *Config) string {
+	if config.DefaultGeneration != "" {
+		return config.DefaultGeneration
+	}
+	return "cedar"
+}
+
+// resolveSpaceDefaults plans the provider's default team, region and
+// generation for a space which does not set them.
+func resolveSpaceDefaults(d *schema.ResourceDiff, config *Config) error {
+	if isUnsetInConfig(d, "organization") {
+		if config.DefaultTeam == "" {
+			return fmt.Errorf("organization must be set, either on the space or as the team in the provider's defaults block")
+		}
+		if err := d.SetNew("organization", config.DefaultTeam); err != nil {
+			return err
+		}
+	}
+
+	if config.DefaultRegion != "" {
+		if err := setProviderDefault(d, "region", config.DefaultRegion); err != nil {
+			return err
+		}
+	}
+
+	return setProviderDefault(d, "generation", spaceGeneration(config))
+}
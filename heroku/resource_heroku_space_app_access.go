@@ -3,19 +3,20 @@ package heroku
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	heroku "github.com/heroku/heroku-go/v6"
 )
 
 func resourceHerokuSpaceAppAccess() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuSpaceAppAccessSet,
-		Read:   resourceHerokuSpaceAppAccessRead,
-		Update: resourceHerokuSpaceAppAccessSet,
-		Delete: resourceHerokuSpaceAppAccessDelete,
+		CreateContext: resourceHerokuSpaceAppAccessSet,
+		ReadContext:   resourceHerokuSpaceAppAccessRead,
+		UpdateContext: resourceHerokuSpaceAppAccessSet,
+		DeleteContext: resourceHerokuSpaceAppAccessDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuSpaceAppAccessImport,
+			StateContext: resourceHerokuSpaceAppAccessImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,14 +44,14 @@ func resourceHerokuSpaceAppAccess() *schema.Resource {
 }
 
 // callback for schema.ResourceImporter
-func resourceHerokuSpaceAppAccessImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuSpaceAppAccessImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	space, email, err := parseCompositeID(d.Id())
 	if err != nil {
 		return nil, err
 	}
 	d.Set("space", space)
 	d.Set("email", email)
-	readErr := resourceHerokuSpaceAppAccessRead(d, meta)
+	readErr := diagnosticsError(resourceHerokuSpaceAppAccessRead(ctx, d, meta))
 	if readErr != nil {
 		return nil, readErr
 	}
@@ -58,22 +59,22 @@ func resourceHerokuSpaceAppAccessImport(d *schema.ResourceData, meta interface{}
 }
 
 // callback for schema Resource.Create and schema Resource.Update
-func resourceHerokuSpaceAppAccessSet(d *schema.ResourceData, meta interface{}) error {
-	_, err := updateSpaceAppAccess(d.Get("permissions").(*schema.Set), d, meta)
+func resourceHerokuSpaceAppAccessSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, err := updateSpaceAppAccess(ctx, d.Get("permissions").(*schema.Set), d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
-	return resourceHerokuSpaceAppAccessRead(d, meta)
+	return resourceHerokuSpaceAppAccessRead(ctx, d, meta)
 }
 
 // callback for schema Resource.Read
-func resourceHerokuSpaceAppAccessRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpaceAppAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	space := d.Get("space").(string)
 	email := d.Get("email").(string)
-	spaceAppAccess, err := client.SpaceAppAccessInfo(context.TODO(), space, email)
+	spaceAppAccess, err := client.SpaceAppAccessInfo(ctx, space, email)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId(spaceAppAccess.User.ID)
 	d.Set("email", spaceAppAccess.User.Email)
@@ -84,22 +85,22 @@ func resourceHerokuSpaceAppAccessRead(d *schema.ResourceData, meta interface{})
 // callback for schema Resource.Delete
 // Members cannot be deleted from a space with this resource, they are removed
 // from the state file and their permissions are cleared out.
-func resourceHerokuSpaceAppAccessDelete(d *schema.ResourceData, meta interface{}) error {
-	_, err := updateSpaceAppAccess(nil, d, meta)
+func resourceHerokuSpaceAppAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	_, err := updateSpaceAppAccess(ctx, nil, d, meta)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 	d.SetId("")
 	return nil
 }
 
 // utility method to call heroku.SpaceAppAccessUpdate
-func updateSpaceAppAccess(permissions *schema.Set, d *schema.ResourceData, meta interface{}) (*heroku.SpaceAppAccess, error) {
+func updateSpaceAppAccess(ctx context.Context, permissions *schema.Set, d *schema.ResourceData, meta interface{}) (*heroku.SpaceAppAccess, error) {
 	email := d.Get("email").(string)
 	space := d.Get("space").(string)
 	opts := createSpaceAppAccessUpdateOpts(permissions)
 	client := meta.(*Config).Api
-	spaceAppAccess, err := client.SpaceAppAccessUpdate(context.TODO(), space, email, opts)
+	spaceAppAccess, err := client.SpaceAppAccessUpdate(ctx, space, email, opts)
 	if err != nil {
 		return nil, err
 	}
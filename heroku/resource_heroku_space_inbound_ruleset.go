@@ -2,8 +2,8 @@ package heroku
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	heroku "github.com/heroku/heroku-go/v6"
@@ -11,10 +11,10 @@ import (
 
 func resourceHerokuSpaceInboundRuleset() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuSpaceInboundRulesetSet,
-		Read:   resourceHerokuSpaceInboundRulesetRead,
-		Update: resourceHerokuSpaceInboundRulesetSet,
-		Delete: resourceHerokuSpaceInboundRulesetDelete,
+		CreateContext: resourceHerokuSpaceInboundRulesetSet,
+		ReadContext:   resourceHerokuSpaceInboundRulesetRead,
+		UpdateContext: resourceHerokuSpaceInboundRulesetSet,
+		DeleteContext: resourceHerokuSpaceInboundRulesetDelete,
 
 		Schema: map[string]*schema.Schema{
 			"space": {
@@ -68,27 +68,27 @@ func getRulesetFromSchema(d *schema.ResourceData) heroku.InboundRulesetCreateOpt
 	return heroku.InboundRulesetCreateOpts{Rules: ruleset}
 }
 
-func resourceHerokuSpaceInboundRulesetSet(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpaceInboundRulesetSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	spaceIdentity := d.Get("space").(string)
 	ruleset := getRulesetFromSchema(d)
 
-	_, err := client.InboundRulesetCreate(context.TODO(), spaceIdentity, ruleset)
+	_, err := client.InboundRulesetCreate(ctx, spaceIdentity, ruleset)
 	if err != nil {
-		return fmt.Errorf("Error creating inbound ruleset for space (%s): %s", spaceIdentity, err)
+		return diag.Errorf("Error creating inbound ruleset for space (%s): %s", spaceIdentity, err)
 	}
 
-	return resourceHerokuSpaceInboundRulesetRead(d, meta)
+	return resourceHerokuSpaceInboundRulesetRead(ctx, d, meta)
 }
 
-func resourceHerokuSpaceInboundRulesetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpaceInboundRulesetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	spaceIdentity := d.Get("space").(string)
-	ruleset, err := client.InboundRulesetCurrent(context.TODO(), spaceIdentity)
+	ruleset, err := client.InboundRulesetCurrent(ctx, spaceIdentity)
 	if err != nil {
-		return fmt.Errorf("Error creating inbound ruleset for space (%s): %s", spaceIdentity, err)
+		return diag.Errorf("Error creating inbound ruleset for space (%s): %s", spaceIdentity, err)
 	}
 
 	rulesList := []interface{}{}
@@ -105,7 +105,7 @@ func resourceHerokuSpaceInboundRulesetRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceHerokuSpaceInboundRulesetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpaceInboundRulesetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	spaceIdentity := d.Get("space").(string)
@@ -127,9 +127,9 @@ func resourceHerokuSpaceInboundRulesetDelete(d *schema.ResourceData, meta interf
 		Source: "0.0.0.0/0",
 	})
 
-	_, err := client.InboundRulesetCreate(context.TODO(), spaceIdentity, heroku.InboundRulesetCreateOpts{Rules: rules})
+	_, err := client.InboundRulesetCreate(ctx, spaceIdentity, heroku.InboundRulesetCreateOpts{Rules: rules})
 	if err != nil {
-		return fmt.Errorf("Error resetting inbound ruleset for space (%s): %s", spaceIdentity, err)
+		return diag.Errorf("Error resetting inbound ruleset for space (%s): %s", spaceIdentity, err)
 	}
 
 	d.SetId("")
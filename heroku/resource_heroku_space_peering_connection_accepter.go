@@ -2,10 +2,10 @@ package heroku
 
 import (
 	"context"
-	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	heroku "github.com/heroku/heroku-go/v6"
@@ -17,9 +17,9 @@ type spacePeerInfo struct {
 
 func resourceHerokuSpacePeeringConnectionAccepter() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuSpacePeeringConnectionAccepterCreate,
-		Read:   resourceHerokuSpacePeeringConnectionAccepterRead,
-		Delete: resourceHerokuSpacePeeringConnectionAccepterDelete,
+		CreateContext: resourceHerokuSpacePeeringConnectionAccepterCreate,
+		ReadContext:   resourceHerokuSpacePeeringConnectionAccepterRead,
+		DeleteContext: resourceHerokuSpacePeeringConnectionAccepterDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceHerokuSpacePeeringConnectionAccepterImport,
@@ -48,6 +48,10 @@ func resourceHerokuSpacePeeringConnectionAccepter() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(25 * time.Minute),
+		},
 	}
 }
 
@@ -69,7 +73,7 @@ func resourceHerokuSpacePeeringConnectionAccepterImport(ctx context.Context, d *
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceHerokuSpacePeeringConnectionAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpacePeeringConnectionAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	spaceIdentity := d.Get("space").(string)
@@ -79,8 +83,8 @@ func resourceHerokuSpacePeeringConnectionAccepterCreate(d *schema.ResourceData,
 	// appears as an option in a space's list of peering connections. In testing, this is
 	// usually in the 1-3 minute range. We retry for 5 minutes so plan/apply runs that
 	// create the two resources at the same time don't result in an error.
-	retryError := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		_, err := client.PeeringAccept(context.TODO(), spaceIdentity, pcxID)
+	retryError := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+		_, err := client.PeeringAccept(ctx, spaceIdentity, pcxID)
 		if err != nil {
 			return resource.RetryableError(err)
 		}
@@ -90,7 +94,7 @@ func resourceHerokuSpacePeeringConnectionAccepterCreate(d *schema.ResourceData,
 	})
 
 	if retryError != nil {
-		return fmt.Errorf("[ERROR] Unable to accept peer connection %s to %s", pcxID, spaceIdentity)
+		return diag.Errorf("[ERROR] Unable to accept peer connection %s to %s", pcxID, spaceIdentity)
 	}
 
 	log.Printf("[INFO] Space ID: %s, Peering Connection ID: %s", spaceIdentity, pcxID)
@@ -102,13 +106,13 @@ func resourceHerokuSpacePeeringConnectionAccepterCreate(d *schema.ResourceData,
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"initiating-request", "pending", "pending-acceptance", "provisioning"},
 		Target:  []string{"active"},
-		Refresh: SpacePeeringConnAccepterStateRefreshFunc(client, spaceIdentity, d.Id()),
-		Timeout: 20 * time.Minute,
+		Refresh: SpacePeeringConnAccepterStateRefreshFunc(ctx, client, spaceIdentity, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
-	finalPeerConn, err := stateConf.WaitForState()
+	finalPeerConn, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf("Error waiting for Space (%s) to become available: %s", d.Id(), err)
+		return diag.Errorf("Error waiting for Space (%s) to become available: %s", d.Id(), err)
 	}
 
 	p := finalPeerConn.(*spacePeerInfo)
@@ -125,14 +129,14 @@ func setPeeringConnectionAccepterProperties(d *schema.ResourceData, peeringConn
 	d.Set("vpc_peering_connection_id", peeringConn.PcxID)
 }
 
-func resourceHerokuSpacePeeringConnectionAccepterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpacePeeringConnectionAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	spaceIdentity := d.Get("space").(string)
 
-	peeringConn, err := client.PeeringInfo(context.TODO(), spaceIdentity, d.Id())
+	peeringConn, err := client.PeeringInfo(ctx, spaceIdentity, d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(peeringConn.PcxID)
@@ -141,14 +145,14 @@ func resourceHerokuSpacePeeringConnectionAccepterRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceHerokuSpacePeeringConnectionAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpacePeeringConnectionAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	log.Printf("[INFO] Deleting space peering connection: %s", d.Id())
 
-	_, err := client.PeeringDestroy(context.TODO(), d.Get("space").(string), d.Id())
+	_, err := client.PeeringDestroy(ctx, d.Get("space").(string), d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -157,9 +161,9 @@ func resourceHerokuSpacePeeringConnectionAccepterDelete(d *schema.ResourceData,
 
 // SpaceStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
 // a Space peering connection. Connections go through a provisioning process.
-func SpacePeeringConnAccepterStateRefreshFunc(client *heroku.Service, spaceIdentity string, pcxID string) resource.StateRefreshFunc {
+func SpacePeeringConnAccepterStateRefreshFunc(ctx context.Context, client *heroku.Service, spaceIdentity string, pcxID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		peeringConn, err := client.PeeringInfo(context.TODO(), spaceIdentity, pcxID)
+		peeringConn, err := client.PeeringInfo(ctx, spaceIdentity, pcxID)
 		if err != nil {
 			return nil, "", err
 		}
@@ -2,10 +2,12 @@ package heroku
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"testing"
 
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -285,6 +287,8 @@ func TestHerokuSpaceGeneration(t *testing.T) {
 			// Check default generation behavior
 			generation := d.Get("generation").(string)
 			if tt.config["generation"] == nil {
+				// The default is resolved at plan time, rather than by the schema.
+				generation = planSpaceDefaults(t, tt.config, NewConfig()).Attributes["generation"].New
 				if generation != "cedar" {
 					t.Errorf("Expected default generation to be 'cedar', got '%s'", generation)
 				}
@@ -306,3 +310,59 @@ func TestHerokuSpaceGeneration(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveSpaceDefaults_Generation(t *testing.T) {
+	tests := []struct {
+		name              string
+		defaultGeneration string
+		generation        string
+		want              string
+	}{
+		{"without defaults", "", "", "cedar"},
+		{"with defaults.generation", "fir", "", "fir"},
+		{"set on the space", "fir", "cedar", "cedar"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"name":         "test-space",
+				"organization": "test-org",
+			}
+			if tt.generation != "" {
+				raw["generation"] = tt.generation
+			}
+
+			config := NewConfig()
+			config.DefaultGeneration = tt.defaultGeneration
+
+			if got := planSpaceDefaults(t, raw, config).Attributes["generation"].New; got != tt.want {
+				t.Errorf("planned generation %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// planSpaceDefaults plans a new space with the given configuration, resolving
+// the provider's defaults without calling the Platform API.
+func planSpaceDefaults(t *testing.T, raw map[string]interface{}, config *Config) *terraform.InstanceDiff {
+	r := resourceHerokuSpace()
+	r.CustomizeDiff = func(_ context.Context, d *schema.ResourceDiff, v interface{}) error {
+		return resolveSpaceDefaults(d, v.(*Config))
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rawConfig, err := ctyjson.Unmarshal(b, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := r.Diff(context.Background(), &terraform.InstanceState{RawConfig: rawConfig}, terraform.NewResourceConfigRaw(raw), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return diff
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	heroku "github.com/heroku/heroku-go/v6"
@@ -14,12 +15,12 @@ import (
 
 func resourceHerokuSpaceVPNConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuSpaceVPNConnectionCreate,
-		Read:   resourceHerokuSpaceVPNConnectionRead,
-		Delete: resourceHerokuSpaceVPNConnectionDelete,
+		CreateContext: resourceHerokuSpaceVPNConnectionCreate,
+		ReadContext:   resourceHerokuSpaceVPNConnectionRead,
+		DeleteContext: resourceHerokuSpaceVPNConnectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -86,16 +87,16 @@ func resourceHerokuSpaceVPNConnection() *schema.Resource {
 	}
 }
 
-func resourceHerokuSpaceVPNConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpaceVPNConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	space, id, err := parseCompositeID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	conn, err := client.VPNConnectionInfo(context.TODO(), space, id)
+	conn, err := client.VPNConnectionInfo(ctx, space, id)
 	if err != nil {
-		return fmt.Errorf("Error reading VPN information: %v", err)
+		return diag.Errorf("Error reading VPN information: %v", err)
 	}
 
 	d.Set("space", space)
@@ -117,7 +118,7 @@ func resourceHerokuSpaceVPNConnectionRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceHerokuSpaceVPNConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpaceVPNConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	space := d.Get("space").(string)
 
@@ -126,52 +127,52 @@ func resourceHerokuSpaceVPNConnectionCreate(d *schema.ResourceData, meta interfa
 		routableCIDRs = append(routableCIDRs, v.(string))
 	}
 
-	conn, err := client.VPNConnectionCreate(context.TODO(), space, heroku.VPNConnectionCreateOpts{
+	conn, err := client.VPNConnectionCreate(ctx, space, heroku.VPNConnectionCreateOpts{
 		Name:          d.Get("name").(string),
 		PublicIP:      d.Get("public_ip").(string),
 		RoutableCidrs: routableCIDRs,
 	})
 	if err != nil {
-		return fmt.Errorf("Error creating VPN: %v", err)
+		return diag.Errorf("Error creating VPN: %v", err)
 	}
 
 	log.Printf("[DEBUG] Waiting for VPN (%s) to be allocated", conn.ID)
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{"pending", "provisioning"},
 		Target:       []string{"active"},
-		Refresh:      spaceVPNConnectionStateRefreshFunc(client, space, conn.ID),
-		Timeout:      30 * time.Minute,
+		Refresh:      spaceVPNConnectionStateRefreshFunc(ctx, client, space, conn.ID),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
 		PollInterval: 20 * time.Second,
 	}
 
-	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("error waiting for VPN to become available: %s", err)
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for VPN to become available: %s", err)
 	}
 
 	d.SetId(buildCompositeID(space, conn.ID))
 
-	return resourceHerokuSpaceVPNConnectionRead(d, meta)
+	return resourceHerokuSpaceVPNConnectionRead(ctx, d, meta)
 }
 
-func resourceHerokuSpaceVPNConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuSpaceVPNConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	space, id, err := parseCompositeID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	_, err = client.VPNConnectionDestroy(context.TODO(), space, id)
+	_, err = client.VPNConnectionDestroy(ctx, space, id)
 	if err != nil {
-		return fmt.Errorf("Error deleting VPN: %v", err)
+		return diag.Errorf("Error deleting VPN: %v", err)
 	}
 
 	d.SetId("")
 	return nil
 }
 
-func spaceVPNConnectionStateRefreshFunc(client *heroku.Service, space, connectionID string) resource.StateRefreshFunc {
+func spaceVPNConnectionStateRefreshFunc(ctx context.Context, client *heroku.Service, space, connectionID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		vpn, vpnGetErr := client.VPNConnectionInfo(context.TODO(), space, connectionID)
+		vpn, vpnGetErr := client.VPNConnectionInfo(ctx, space, connectionID)
 
 		// Retry on "not found"
 		if vpnGetErr != nil && strings.Contains(vpnGetErr.Error(), "VPN is not found") {
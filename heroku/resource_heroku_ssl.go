@@ -19,7 +19,7 @@ func resourceHerokuSSL() *schema.Resource {
 		DeleteContext: resourceHerokuSSLDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuSSLImport,
+			StateContext: resourceHerokuSSLImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -49,7 +49,7 @@ func resourceHerokuSSL() *schema.Resource {
 	}
 }
 
-func resourceHerokuSSLImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuSSLImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*Config).Api
 
 	app, certID, err := parseCompositeID(d.Id())
@@ -57,7 +57,7 @@ func resourceHerokuSSLImport(d *schema.ResourceData, meta interface{}) ([]*schem
 		return nil, err
 	}
 
-	ep, err := client.SniEndpointInfo(context.Background(), app, certID)
+	ep, err := client.SniEndpointInfo(ctx, app, certID)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +81,7 @@ func resourceHerokuSSLCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	log.Printf("[DEBUG] Creating SSL certificate for app %#v", appID)
 
-	ep, err := client.SniEndpointCreate(context.TODO(), appID, opts)
+	ep, err := client.SniEndpointCreate(ctx, appID, opts)
 	if err != nil {
 		return diag.Errorf("Error creating SSL certificate for app %s: %v", appID, err.Error())
 	}
@@ -96,7 +96,7 @@ func resourceHerokuSSLCreate(ctx context.Context, d *schema.ResourceData, meta i
 func resourceHerokuSSLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
-	ep, err := client.SniEndpointInfo(context.Background(), getAppId(d), d.Id())
+	ep, err := client.SniEndpointInfo(ctx, getAppId(d), d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -120,14 +120,14 @@ func resourceHerokuSSLUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			PrivateKey:       d.Get("private_key").(string),
 		}
 
-		log.Printf("[DEBUG] Updating SSL Certificate configuration: %#v, %#v", appID, opts)
+		log.Printf("[DEBUG] Updating SSL Certificate %s for app %s", d.Id(), appID)
 
-		_, err := client.SniEndpointUpdate(context.TODO(), appID, d.Id(), opts)
+		_, err := client.SniEndpointUpdate(ctx, appID, d.Id(), opts)
 		if err != nil {
 			return diag.Errorf("Error updating Sni endpoint: %s", err)
 		}
 
-		log.Printf("[DEBUG] Updated SSL Certificate configuration: %#v, %#v", appID, opts)
+		log.Printf("[DEBUG] Updated SSL Certificate %s for app %s", d.Id(), appID)
 	}
 
 	return resourceHerokuSSLRead(ctx, d, meta)
@@ -138,7 +138,7 @@ func resourceHerokuSSLDelete(ctx context.Context, d *schema.ResourceData, meta i
 
 	log.Printf("[INFO] Deleting SSL Cert: %s", d.Id())
 
-	_, err := client.SniEndpointDelete(context.TODO(), getAppId(d), d.Id())
+	_, err := client.SniEndpointDelete(ctx, getAppId(d), d.Id())
 	if err != nil {
 		return diag.Errorf("Error deleting SSL Cert: %s", err)
 	}
@@ -0,0 +1,191 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+func resourceHerokuTeam() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuTeamCreate,
+		ReadContext:   resourceHerokuTeamRead,
+		UpdateContext: resourceHerokuTeamUpdate,
+		DeleteContext: resourceHerokuTeamDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHerokuTeamImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"enterprise_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"enterprise_account_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"credit_card_collections": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"membership_limit": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"provisioned_licenses": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHerokuTeamImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Config).Api
+
+	team, err := client.TeamInfo(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(team.ID)
+	setTeamAttributes(d, team)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceHerokuTeamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	name := d.Get("name").(string)
+
+	var team *heroku.Team
+	var err error
+	if v, ok := d.GetOk("enterprise_account_id"); ok {
+		enterpriseAccountID := v.(string)
+		log.Printf("[DEBUG] Creating team %s in enterprise account %s", name, enterpriseAccountID)
+		team, err = client.TeamCreateInEnterpriseAccount(ctx, enterpriseAccountID, heroku.TeamCreateInEnterpriseAccountOpts{Name: name})
+	} else {
+		log.Printf("[DEBUG] Creating team %s", name)
+		team, err = client.TeamCreate(ctx, heroku.TeamCreateOpts{Name: name})
+	}
+	if err != nil {
+		return diag.Errorf("Error creating team %s: %s", name, err)
+	}
+
+	d.SetId(team.ID)
+	log.Printf("[INFO] Team ID: %s", d.Id())
+
+	// Teams cannot be created as the default team, so set it afterwards.
+	if d.Get("default").(bool) && !team.Default {
+		isDefault := true
+		if _, err := client.TeamUpdate(ctx, team.ID, heroku.TeamUpdateOpts{Default: &isDefault}); err != nil {
+			return diag.Errorf("Error setting team %s as the default: %s", name, err)
+		}
+	}
+
+	return resourceHerokuTeamRead(ctx, d, meta)
+}
+
+func resourceHerokuTeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	team, err := client.TeamInfo(ctx, d.Id())
+	if isNotFoundError(err) {
+		logWarn(ctx, fmt.Sprintf("Team %s no longer exists, removing it from state", d.Id()))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("Error retrieving team: %s", err)
+	}
+
+	setTeamAttributes(d, team)
+
+	return nil
+}
+
+func setTeamAttributes(d *schema.ResourceData, team *heroku.Team) {
+	d.Set("name", team.Name)
+	d.Set("default", team.Default)
+	d.Set("credit_card_collections", team.CreditCardCollections)
+	d.Set("provisioned_licenses", team.ProvisionedLicenses)
+	d.Set("type", team.Type)
+
+	if team.MembershipLimit != nil {
+		d.Set("membership_limit", int(*team.MembershipLimit))
+	}
+
+	if team.EnterpriseAccount != nil {
+		d.Set("enterprise_account_id", team.EnterpriseAccount.ID)
+		d.Set("enterprise_account_name", team.EnterpriseAccount.Name)
+	} else {
+		d.Set("enterprise_account_id", "")
+		d.Set("enterprise_account_name", "")
+	}
+}
+
+func resourceHerokuTeamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	opts := heroku.TeamUpdateOpts{}
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		opts.Name = &name
+	}
+	if d.HasChange("default") {
+		isDefault := d.Get("default").(bool)
+		opts.Default = &isDefault
+	}
+
+	log.Printf("[DEBUG] Updating team %s", d.Id())
+	if _, err := client.TeamUpdate(ctx, d.Id(), opts); err != nil {
+		return diag.Errorf("Error updating team: %s", err)
+	}
+
+	return resourceHerokuTeamRead(ctx, d, meta)
+}
+
+func resourceHerokuTeamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	log.Printf("[INFO] Deleting team: %s", d.Id())
+	if _, err := client.TeamDelete(ctx, d.Id()); err != nil {
+		return diag.Errorf("Error deleting team: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
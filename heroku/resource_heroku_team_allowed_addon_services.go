@@ -0,0 +1,182 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+// resourceHerokuTeamAllowedAddonServices authoritatively manages the add-on
+// services a team's members may install. Services allowed outside of
+// Terraform are removed. A team with no allowed services may install any.
+func resourceHerokuTeamAllowedAddonServices() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuTeamAllowedAddonServicesCreate,
+		ReadContext:   resourceHerokuTeamAllowedAddonServicesRead,
+		UpdateContext: resourceHerokuTeamAllowedAddonServicesUpdate,
+		DeleteContext: resourceHerokuTeamAllowedAddonServicesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHerokuTeamAllowedAddonServicesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"addon_services": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func resourceHerokuTeamAllowedAddonServicesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("team", d.Id())
+
+	readErr := diagnosticsError(resourceHerokuTeamAllowedAddonServicesRead(ctx, d, meta))
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceHerokuTeamAllowedAddonServicesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	team := d.Get("team").(string)
+
+	if err := syncTeamAllowedAddonServices(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(team)
+
+	return resourceHerokuTeamAllowedAddonServicesRead(ctx, d, meta)
+}
+
+func resourceHerokuTeamAllowedAddonServicesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	team := d.Id()
+
+	allowed, err := listTeamAllowedAddonServices(ctx, client, team)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	services := make([]string, 0, len(allowed))
+	for _, a := range allowed {
+		services = append(services, a.AddonService.Name)
+	}
+
+	d.Set("team", team)
+	d.Set("addon_services", services)
+
+	return nil
+}
+
+func resourceHerokuTeamAllowedAddonServicesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("addon_services") {
+		if err := syncTeamAllowedAddonServices(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceHerokuTeamAllowedAddonServicesRead(ctx, d, meta)
+}
+
+// Removing the allow-list lets the team's members install any add-on service.
+func resourceHerokuTeamAllowedAddonServicesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	team := d.Id()
+
+	allowed, err := listTeamAllowedAddonServices(ctx, client, team)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	services := d.Get("addon_services").(*schema.Set)
+	for _, a := range allowed {
+		if !services.Contains(a.AddonService.Name) {
+			continue
+		}
+
+		log.Printf("[INFO] Removing %s from the allowed add-on services of team %s", a.AddonService.Name, team)
+		if _, err := client.AllowedAddOnServiceDeleteByTeam(ctx, team, a.ID); err != nil && !isNotFoundError(err) {
+			return diag.Errorf("Error removing %s from the allowed add-on services of team %s: %s", a.AddonService.Name, team, err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func listTeamAllowedAddonServices(ctx context.Context, client *heroku.Service, team string) ([]heroku.AllowedAddOnService, error) {
+	allowed, err := listAllByID(func(lr *heroku.ListRange) ([]heroku.AllowedAddOnService, error) {
+		return client.AllowedAddOnServiceListByTeam(ctx, team, lr)
+	}, func(a heroku.AllowedAddOnService) string { return a.ID })
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the allowed add-on services of team %s: %s", team, err)
+	}
+	return allowed, nil
+}
+
+// syncTeamAllowedAddonServices allows the configured add-on services and
+// removes any others. Services are allowed before others are removed, so that
+// the team is never left with an empty, and so unrestricted, allow-list.
+func syncTeamAllowedAddonServices(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Config).Api
+	team := d.Get("team").(string)
+
+	allowed, err := listTeamAllowedAddonServices(ctx, client, team)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[string]string, len(allowed))
+	for _, a := range allowed {
+		current[a.AddonService.Name] = a.ID
+	}
+
+	desired := d.Get("addon_services").(*schema.Set)
+	for _, v := range desired.List() {
+		service := v.(string)
+		if _, ok := current[service]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Allowing add-on service %s for team %s", service, team)
+		opts := heroku.AllowedAddOnServiceCreateByTeamOpts{AddonService: &service}
+		if _, err := client.AllowedAddOnServiceCreateByTeam(ctx, team, opts); err != nil {
+			return fmt.Errorf("Error allowing add-on service %s for team %s: %s", service, team, err)
+		}
+	}
+
+	for service, id := range current {
+		if desired.Contains(service) {
+			continue
+		}
+
+		log.Printf("[INFO] Removing unmanaged add-on service %s from the allowed add-on services of team %s", service, team)
+		if _, err := client.AllowedAddOnServiceDeleteByTeam(ctx, team, id); err != nil {
+			return fmt.Errorf("Error removing %s from the allowed add-on services of team %s: %s", service, team, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package heroku
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHerokuTeamAllowedAddonServices_Basic(t *testing.T) {
+	team := testAccConfig.GetTeamOrSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuTeamAllowedAddonServicesConfig(team, `"heroku-postgresql"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_team_allowed_addon_services.foobar", "addon_services.#", "1"),
+					resource.TestCheckTypeSetElemAttr(
+						"heroku_team_allowed_addon_services.foobar", "addon_services.*", "heroku-postgresql"),
+				),
+			},
+			{
+				Config: testAccCheckHerokuTeamAllowedAddonServicesConfig(team, `"heroku-redis", "scheduler"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_team_allowed_addon_services.foobar", "addon_services.#", "2"),
+					resource.TestCheckTypeSetElemAttr(
+						"heroku_team_allowed_addon_services.foobar", "addon_services.*", "scheduler"),
+				),
+			},
+			{
+				ResourceName:      "heroku_team_allowed_addon_services.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuTeamAllowedAddonServicesConfig(team, services string) string {
+	return fmt.Sprintf(`
+resource "heroku_team_allowed_addon_services" "foobar" {
+	team           = "%s"
+	addon_services = [%s]
+}
+`, team, services)
+}
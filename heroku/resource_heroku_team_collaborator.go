@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -44,13 +45,13 @@ type teamCollaborator struct {
 
 func resourceHerokuTeamCollaborator() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuTeamCollaboratorCreate,
-		Read:   resourceHerokuTeamCollaboratorRead,
-		Update: resourceHerokuTeamCollaboratorUpdate,
-		Delete: resourceHerokuTeamCollaboratorDelete,
+		CreateContext: resourceHerokuTeamCollaboratorCreate,
+		ReadContext:   resourceHerokuTeamCollaboratorRead,
+		UpdateContext: resourceHerokuTeamCollaboratorUpdate,
+		DeleteContext: resourceHerokuTeamCollaboratorDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuTeamCollaboratorImport,
+			StateContext: resourceHerokuTeamCollaboratorImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -77,6 +78,11 @@ func resourceHerokuTeamCollaborator() *schema.Resource {
 				},
 			},
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -88,7 +94,7 @@ func resourceHerokuTeamCollaborator() *schema.Resource {
 	}
 }
 
-func resourceHerokuTeamCollaboratorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuTeamCollaboratorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	opts := heroku.TeamAppCollaboratorCreateOpts{}
@@ -121,15 +127,15 @@ func resourceHerokuTeamCollaboratorCreate(d *schema.ResourceData, meta interface
 	log.Printf("[DEBUG] Creating Heroku Team Collaborator: [%s]", opts.User)
 
 	var resourceID string
-	collaborator, createErr := client.TeamAppCollaboratorCreate(context.TODO(), appID, opts)
+	collaborator, createErr := client.TeamAppCollaboratorCreate(ctx, appID, opts)
 	if createErr != nil {
 		// Handle scenario when user has already been granted access to the app.
 		if strings.Contains(strings.ToLower(createErr.Error()), "is already a collaborator on app") {
 			// Loop through all collaborators on the app to get the collaborator ID
-			collaborators, listErr := client.TeamAppCollaboratorList(context.TODO(), appID,
+			collaborators, listErr := client.TeamAppCollaboratorList(ctx, appID,
 				&heroku.ListRange{Field: "email", Max: 1000})
 			if listErr != nil {
-				return listErr
+				return diag.FromErr(listErr)
 			}
 
 			for _, c := range collaborators {
@@ -139,7 +145,7 @@ func resourceHerokuTeamCollaboratorCreate(d *schema.ResourceData, meta interface
 				}
 			}
 		} else {
-			return createErr
+			return diag.FromErr(createErr)
 		}
 	} else {
 		resourceID = collaborator.ID
@@ -148,13 +154,13 @@ func resourceHerokuTeamCollaboratorCreate(d *schema.ResourceData, meta interface
 	d.SetId(resourceID)
 	log.Printf("[INFO] New Collaborator ID: %s", d.Id())
 
-	return resourceHerokuTeamCollaboratorRead(d, meta)
+	return resourceHerokuTeamCollaboratorRead(ctx, d, meta)
 }
 
-func resourceHerokuTeamCollaboratorRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuTeamCollaboratorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
-	teamCollaborator, err := resourceHerokuTeamCollaboratorRetrieve(d.Id(), d.Get("app_id").(string), client)
+	teamCollaborator, err := resourceHerokuTeamCollaboratorRetrieve(ctx, d.Id(), d.Get("app_id").(string), client)
 
 	if err != nil {
 		if strings.Contains(err.Error(), "Couldn't find that user") {
@@ -162,7 +168,7 @@ func resourceHerokuTeamCollaboratorRead(d *schema.ResourceData, meta interface{}
 			d.SetId("")
 			return nil
 		}
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.Set("app_id", teamCollaborator.AppID)
@@ -172,7 +178,7 @@ func resourceHerokuTeamCollaboratorRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func resourceHerokuTeamCollaboratorUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuTeamCollaboratorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	opts := heroku.TeamAppCollaboratorUpdateOpts{}
 
@@ -192,24 +198,24 @@ func resourceHerokuTeamCollaboratorUpdate(d *schema.ResourceData, meta interface
 	email := getEmail(d)
 
 	log.Printf("[DEBUG] Updating Heroku Team Collaborator: [%s]", email)
-	updatedTeamCollaborator, err := client.TeamAppCollaboratorUpdate(context.TODO(), appID, email, opts)
+	updatedTeamCollaborator, err := client.TeamAppCollaboratorUpdate(ctx, appID, email, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(updatedTeamCollaborator.ID)
 
-	return resourceHerokuTeamCollaboratorRead(d, meta)
+	return resourceHerokuTeamCollaboratorRead(ctx, d, meta)
 }
 
-func resourceHerokuTeamCollaboratorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuTeamCollaboratorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	log.Printf("[INFO] Deleting Heroku Team Collaborator: [%s]", d.Id())
-	_, err := client.TeamAppCollaboratorDelete(context.TODO(), getAppId(d), getEmail(d))
+	_, err := client.TeamAppCollaboratorDelete(ctx, getAppId(d), getEmail(d))
 
 	if err != nil {
-		return fmt.Errorf("error deleting Team Collaborator: %s", err)
+		return diag.Errorf("error deleting Team Collaborator: %s", err)
 	}
 
 	// So long as the DELETE succeeded, remove the resource from state
@@ -221,8 +227,8 @@ func resourceHerokuTeamCollaboratorDelete(d *schema.ResourceData, meta interface
 	until it 404s before proceeding further.
 	*/
 	log.Printf("[INFO] Begin checking if [%s] has been deleted", getEmail(d))
-	retryError := resource.Retry(10*time.Second, func() *resource.RetryError {
-		_, err := client.TeamAppCollaboratorInfo(context.TODO(), getAppId(d), d.Id())
+	retryError := resource.RetryContext(ctx, remainingTimeout(ctx, d.Timeout(schema.TimeoutDelete)), func() *resource.RetryError {
+		_, err := client.TeamAppCollaboratorInfo(ctx, getAppId(d), d.Id())
 
 		// Debug log to check
 		log.Printf("[INFO] Is error nil when GET#show team collaborator? %t", err == nil)
@@ -240,16 +246,16 @@ func resourceHerokuTeamCollaboratorDelete(d *schema.ResourceData, meta interface
 	})
 
 	if retryError != nil {
-		return fmt.Errorf("[ERROR] Team collaborator [%s] still exists on [%s] after checking several times", getEmail(d), getAppId(d))
+		return diag.Errorf("[ERROR] Team collaborator [%s] still exists on [%s] after checking several times", getEmail(d), getAppId(d))
 	}
 
 	return nil
 }
 
-func resourceHerokuTeamCollaboratorRetrieve(id string, appID string, client *heroku.Service) (*teamCollaborator, error) {
+func resourceHerokuTeamCollaboratorRetrieve(ctx context.Context, id string, appID string, client *heroku.Service) (*teamCollaborator, error) {
 	teamCollaborator := teamCollaborator{Id: id, AppID: appID, Client: client}
 
-	err := teamCollaborator.Update()
+	err := teamCollaborator.Update(ctx)
 
 	if err != nil {
 		return nil, fmt.Errorf("[ERROR] Error retrieving team collaborator: %s", err)
@@ -258,12 +264,12 @@ func resourceHerokuTeamCollaboratorRetrieve(id string, appID string, client *her
 	return &teamCollaborator, nil
 }
 
-func (tc *teamCollaborator) Update() error {
+func (tc *teamCollaborator) Update(ctx context.Context) error {
 	var errs []error
 
 	log.Printf("[INFO] tc.Id is %s", tc.Id)
 
-	teamCollaborator, err := tc.Client.TeamAppCollaboratorInfo(context.TODO(), tc.AppID, tc.Id)
+	teamCollaborator, err := tc.Client.TeamAppCollaboratorInfo(ctx, tc.AppID, tc.Id)
 
 	if err != nil {
 		errs = append(errs, err)
@@ -276,7 +282,7 @@ func (tc *teamCollaborator) Update() error {
 
 	// The underlying go client does not return permission info on the collaborator when calling
 	// 'TeamAppCollaboratorInfo'. Instead that is returned via calling 'CollaboratorInfo'
-	collaborator, collaboratorErr := tc.Client.CollaboratorInfo(context.TODO(), tc.AppID, tc.Id)
+	collaborator, collaboratorErr := tc.Client.CollaboratorInfo(ctx, tc.AppID, tc.Id)
 	if collaboratorErr != nil {
 		errs = append(errs, collaboratorErr)
 	} else {
@@ -296,7 +302,7 @@ func (tc *teamCollaborator) Update() error {
 	return nil
 }
 
-func resourceHerokuTeamCollaboratorImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuTeamCollaboratorImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*Config).Api
 
 	app, email, err := parseCompositeID(d.Id())
@@ -304,7 +310,7 @@ func resourceHerokuTeamCollaboratorImport(d *schema.ResourceData, meta interface
 		return nil, err
 	}
 
-	collaborator, err := client.CollaboratorInfo(context.Background(), app, email)
+	collaborator, err := client.CollaboratorInfo(ctx, app, email)
 	if err != nil {
 		return nil, err
 	}
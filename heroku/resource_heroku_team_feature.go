@@ -0,0 +1,158 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Team features can be retrieved but not changed through the Platform API, so
+// this resource asserts that a feature is in the configured state, and plans
+// a change when it drifts.
+func resourceHerokuTeamFeature() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuTeamFeatureCreate,
+		UpdateContext: resourceHerokuTeamFeatureUpdate,
+		ReadContext:   resourceHerokuTeamFeatureRead,
+		DeleteContext: resourceHerokuTeamFeatureDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHerokuTeamFeatureImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHerokuTeamFeatureImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	readErr := diagnosticsError(resourceHerokuTeamFeatureRead(ctx, d, meta))
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceHerokuTeamFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	team, id, err := parseCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	feature, err := client.TeamFeatureInfo(ctx, team, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("team", team)
+	d.Set("name", feature.Name)
+	d.Set("enabled", feature.Enabled)
+	d.Set("description", feature.Description)
+	d.Set("state", feature.State)
+
+	return nil
+}
+
+func resourceHerokuTeamFeatureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	team := d.Get("team").(string)
+	featureName := d.Get("name").(string)
+
+	feature, err := client.TeamFeatureInfo(ctx, team, featureName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := checkTeamFeatureEnabled(team, featureName, feature.Enabled, d.Get("enabled").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildCompositeID(team, feature.ID))
+
+	return resourceHerokuTeamFeatureRead(ctx, d, meta)
+}
+
+func resourceHerokuTeamFeatureUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("enabled") {
+		client := meta.(*Config).Api
+
+		team, id, err := parseCompositeID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		feature, err := client.TeamFeatureInfo(ctx, team, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := checkTeamFeatureEnabled(team, feature.Name, feature.Enabled, d.Get("enabled").(bool)); err != nil {
+			// Keep the prior state, so that the drift is planned again.
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceHerokuTeamFeatureRead(ctx, d, meta)
+}
+
+// Removing a team feature is a no-op, as it cannot be disabled through the
+// Platform API.
+func resourceHerokuTeamFeatureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Team features cannot be changed through the Platform API, so team feature %s is left as it is.", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// checkTeamFeatureEnabled returns an error when a team feature is not in the
+// configured state, as the provider cannot change it.
+func checkTeamFeatureEnabled(team, name string, enabled, want bool) error {
+	if enabled == want {
+		return nil
+	}
+	return fmt.Errorf("Team feature %s of team %s is %s, but is configured to be %s. Team features cannot be changed through the Platform API, so contact Heroku support to change it.",
+		name, team, teamFeatureStatus(enabled), teamFeatureStatus(want))
+}
+
+func teamFeatureStatus(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
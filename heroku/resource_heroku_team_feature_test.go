@@ -0,0 +1,67 @@
+package heroku
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHerokuTeamFeature_Enabled(t *testing.T) {
+	team := testAccConfig.GetTeamOrSkip(t)
+	feature := testAccConfig.GetTeamFeatureOrSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuTeamFeatureConfig(team, feature, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_team_feature.foobar", "name", feature),
+					resource.TestCheckResourceAttr(
+						"heroku_team_feature.foobar", "enabled", "true"),
+					resource.TestCheckResourceAttrSet(
+						"heroku_team_feature.foobar", "state"),
+				),
+			},
+			{
+				ResourceName:      "heroku_team_feature.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccHerokuTeamFeature_Mismatch(t *testing.T) {
+	team := testAccConfig.GetTeamOrSkip(t)
+	feature := testAccConfig.GetTeamFeatureOrSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckHerokuTeamFeatureConfig(team, feature, false),
+				ExpectError: regexp.MustCompile(`is enabled, but is configured to be disabled`),
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuTeamFeatureConfig(team, feature string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "heroku_team_feature" "foobar" {
+	team    = "%s"
+	name    = "%s"
+	enabled = %t
+}
+`, team, feature, enabled)
+}
@@ -0,0 +1,194 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+func resourceHerokuTeamIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuTeamIdentityProviderCreate,
+		ReadContext:   resourceHerokuTeamIdentityProviderRead,
+		UpdateContext: resourceHerokuTeamIdentityProviderUpdate,
+		DeleteContext: resourceHerokuTeamIdentityProviderDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHerokuTeamIdentityProviderImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validatePEMCertificate,
+				DiffSuppressFunc: suppressCertificateWhitespaceDiff,
+			},
+
+			"entity_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"sso_target_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"slo_target_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// The API may not return the certificate exactly as it was sent, so ignore
+// surrounding whitespace.
+func suppressCertificateWhitespaceDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+func resourceHerokuTeamIdentityProviderImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	team, id, err := parseCompositeID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id)
+	d.Set("team", team)
+
+	readErr := diagnosticsError(resourceHerokuTeamIdentityProviderRead(ctx, d, meta))
+	if readErr != nil {
+		return nil, readErr
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("Could not find identity provider %s of team %s", id, team)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceHerokuTeamIdentityProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	team := d.Get("team").(string)
+
+	opts := heroku.IdentityProviderCreateByTeamOpts{
+		Certificate:  d.Get("certificate").(string),
+		EntityID:     d.Get("entity_id").(string),
+		SsoTargetURL: d.Get("sso_target_url").(string),
+	}
+	if v, ok := d.GetOk("slo_target_url"); ok {
+		sloTargetURL := v.(string)
+		opts.SloTargetURL = &sloTargetURL
+	}
+
+	log.Printf("[DEBUG] Creating identity provider for team %s", team)
+	idp, err := client.IdentityProviderCreateByTeam(ctx, team, opts)
+	if err != nil {
+		return diag.Errorf("Error creating identity provider for team %s: %s", team, err)
+	}
+
+	d.SetId(idp.ID)
+	log.Printf("[INFO] Identity provider ID: %s", d.Id())
+
+	return resourceHerokuTeamIdentityProviderRead(ctx, d, meta)
+}
+
+func resourceHerokuTeamIdentityProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	team := d.Get("team").(string)
+
+	// Identity providers can only be listed, not retrieved individually.
+	idps, err := client.IdentityProviderListByTeam(ctx, team, &heroku.ListRange{Field: "id"})
+	if err != nil {
+		return diag.Errorf("Error retrieving identity providers of team %s: %s", team, err)
+	}
+
+	var idp *heroku.IdentityProvider
+	for i := range idps {
+		if idps[i].ID == d.Id() {
+			idp = &idps[i]
+			break
+		}
+	}
+
+	if idp == nil {
+		logWarn(ctx, fmt.Sprintf("Identity provider %s of team %s no longer exists, removing it from state", d.Id(), team))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("certificate", idp.Certificate)
+	d.Set("entity_id", idp.EntityID)
+	d.Set("sso_target_url", idp.SsoTargetURL)
+	d.Set("slo_target_url", idp.SloTargetURL)
+	d.Set("owner_id", idp.Owner.ID)
+
+	return nil
+}
+
+func resourceHerokuTeamIdentityProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	team := d.Get("team").(string)
+
+	opts := heroku.IdentityProviderUpdateByTeamOpts{}
+	if d.HasChange("certificate") {
+		certificate := d.Get("certificate").(string)
+		opts.Certificate = &certificate
+	}
+	if d.HasChange("entity_id") {
+		entityID := d.Get("entity_id").(string)
+		opts.EntityID = &entityID
+	}
+	if d.HasChange("sso_target_url") {
+		ssoTargetURL := d.Get("sso_target_url").(string)
+		opts.SsoTargetURL = &ssoTargetURL
+	}
+	if d.HasChange("slo_target_url") {
+		sloTargetURL := d.Get("slo_target_url").(string)
+		opts.SloTargetURL = &sloTargetURL
+	}
+
+	log.Printf("[DEBUG] Updating identity provider %s of team %s", d.Id(), team)
+	if _, err := client.IdentityProviderUpdateByTeam(ctx, team, d.Id(), opts); err != nil {
+		return diag.Errorf("Error updating identity provider %s of team %s: %s", d.Id(), team, err)
+	}
+
+	return resourceHerokuTeamIdentityProviderRead(ctx, d, meta)
+}
+
+func resourceHerokuTeamIdentityProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	team := d.Get("team").(string)
+
+	log.Printf("[INFO] Deleting identity provider %s of team %s", d.Id(), team)
+	if _, err := client.IdentityProviderDeleteByTeam(ctx, team, d.Id()); err != nil {
+		return diag.Errorf("Error deleting identity provider %s of team %s: %s", d.Id(), team, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package heroku
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccHerokuTeamIdentityProvider_Basic(t *testing.T) {
+	team := testAccConfig.GetTeamOrSkip(t)
+	certPath, _, _ := newTestClientCertificate(t)
+	certificate, err := os.ReadFile(certPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuTeamIdentityProviderConfig(team, string(certificate), "https://idp.example.com/sso"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_team_identity_provider.foobar", "team", team),
+					resource.TestCheckResourceAttr(
+						"heroku_team_identity_provider.foobar", "entity_id", "https://idp.example.com/entity"),
+					resource.TestCheckResourceAttr(
+						"heroku_team_identity_provider.foobar", "sso_target_url", "https://idp.example.com/sso"),
+				),
+			},
+			{
+				Config: testAccCheckHerokuTeamIdentityProviderConfig(team, string(certificate), "https://idp.example.com/sso/saml"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_team_identity_provider.foobar", "sso_target_url", "https://idp.example.com/sso/saml"),
+				),
+			},
+			{
+				ResourceName:      "heroku_team_identity_provider.foobar",
+				ImportStateIdFunc: testAccHerokuTeamIdentityProviderImportStateIdFunc("heroku_team_identity_provider.foobar"),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccHerokuTeamIdentityProvider_InvalidCertificate(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckHerokuTeamIdentityProviderConfig("my-team", "not a certificate", "https://idp.example.com/sso"),
+				ExpectError: regexp.MustCompile(`invalid certificate: no PEM data found`),
+			},
+		},
+	})
+}
+
+func testAccHerokuTeamIdentityProviderImportStateIdFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return buildCompositeID(rs.Primary.Attributes["team"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckHerokuTeamIdentityProviderConfig(team, certificate, ssoTargetURL string) string {
+	return fmt.Sprintf(`
+resource "heroku_team_identity_provider" "foobar" {
+	team           = "%s"
+	certificate    = <<EOT
+%s
+EOT
+	entity_id      = "https://idp.example.com/entity"
+	sso_target_url = "%s"
+}
+`, team, certificate, ssoTargetURL)
+}
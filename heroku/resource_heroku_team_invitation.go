@@ -0,0 +1,188 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+// An invitation is pending until the user signs up or signs in and accepts
+// it, at which point they become a member of the team.
+const (
+	teamInvitationStatePending  = "pending"
+	teamInvitationStateAccepted = "accepted"
+)
+
+func resourceHerokuTeamInvitation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuTeamInvitationCreate,
+		ReadContext:   resourceHerokuTeamInvitationRead,
+		DeleteContext: resourceHerokuTeamInvitationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHerokuTeamInvitationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"invitation_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Callback for schema.ResourceImporter
+func resourceHerokuTeamInvitationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	team, email, err := parseCompositeID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("team", team)
+	d.Set("email", email)
+
+	readErr := diagnosticsError(resourceHerokuTeamInvitationRead(ctx, d, meta))
+	if readErr != nil {
+		return nil, readErr
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("Could not find an invitation or membership for %s on team %s", email, team)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// Callback for schema Resource.Create
+func resourceHerokuTeamInvitationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+
+	team := d.Get("team").(string)
+	email := d.Get("email").(string)
+	role := d.Get("role").(string)
+
+	log.Printf("[DEBUG] Inviting %s to team %s as %s", email, team, role)
+	invitation, err := client.TeamInvitationCreate(ctx, team, heroku.TeamInvitationCreateOpts{
+		Email: email,
+		Role:  &role,
+	})
+	if err != nil {
+		return diag.Errorf("Error inviting %s to team %s: %s", email, team, err)
+	}
+
+	d.SetId(buildCompositeID(team, email))
+	d.Set("invitation_id", invitation.ID)
+
+	return resourceHerokuTeamInvitationRead(ctx, d, meta)
+}
+
+// Callback for schema Resource.Read
+//
+// Accepted invitations are no longer listed, so the team's members are
+// checked to tell an accepted invitation from a revoked or expired one.
+func resourceHerokuTeamInvitationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	team, email, err := parseCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	invitations, err := client.TeamInvitationList(ctx, team, &heroku.ListRange{Field: "id", Max: 1000})
+	if err != nil {
+		return diag.Errorf("Error retrieving invitations of team %s: %s", team, err)
+	}
+
+	for _, invitation := range invitations {
+		if strings.EqualFold(invitation.User.Email, email) {
+			d.Set("team", team)
+			d.Set("email", email)
+			d.Set("invitation_id", invitation.ID)
+			d.Set("state", teamInvitationStatePending)
+			if invitation.Role != nil {
+				d.Set("role", *invitation.Role)
+			}
+			return nil
+		}
+	}
+
+	members, err := client.TeamMemberList(ctx, team, &heroku.ListRange{Field: "email", Max: 1000})
+	if err != nil {
+		return diag.Errorf("Error retrieving members of team %s: %s", team, err)
+	}
+
+	for _, member := range members {
+		if strings.EqualFold(member.Email, email) {
+			// The membership is now managed by heroku_team_member, if at all,
+			// so its role is not refreshed here.
+			log.Printf("[DEBUG] Invitation of %s to team %s was accepted", email, team)
+			d.Set("team", team)
+			d.Set("email", email)
+			d.Set("state", teamInvitationStateAccepted)
+			if d.Get("role").(string) == "" && member.Role != nil {
+				d.Set("role", *member.Role)
+			}
+			return nil
+		}
+	}
+
+	logWarn(ctx, fmt.Sprintf("Invitation of %s to team %s was revoked or has expired, so a new invitation will be planned", email, team))
+	d.SetId("")
+
+	return nil
+}
+
+// Callback for schema Resource.Delete
+//
+// A pending invitation is revoked. Removing an accepted invitation is a no-op
+// which leaves the user in the team.
+func resourceHerokuTeamInvitationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	team, email, err := parseCompositeID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("state").(string) != teamInvitationStatePending {
+		log.Printf("[INFO] Invitation of %s to team %s was accepted, so removing it is a no-op. The user remains a member.", email, team)
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[INFO] Revoking invitation of %s to team %s", email, team)
+	if _, err := client.TeamInvitationRevoke(ctx, team, d.Get("invitation_id").(string)); err != nil && !isNotFoundError(err) {
+		return diag.Errorf("Error revoking invitation of %s to team %s: %s", email, team, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
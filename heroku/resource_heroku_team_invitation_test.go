@@ -0,0 +1,83 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+func TestAccHerokuTeamInvitation_Basic(t *testing.T) {
+	team := testAccConfig.GetTeamOrSkip(t)
+	email := fmt.Sprintf("tftest-%s@example.com", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHerokuTeamInvitationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuTeamInvitationConfig(team, email, "member"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_team_invitation.foobar", "state", "pending"),
+					resource.TestCheckResourceAttr(
+						"heroku_team_invitation.foobar", "role", "member"),
+					resource.TestCheckResourceAttrSet(
+						"heroku_team_invitation.foobar", "invitation_id"),
+				),
+			},
+			{
+				ResourceName:      "heroku_team_invitation.foobar",
+				ImportStateId:     buildCompositeID(team, email),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuTeamInvitationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).Api
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "heroku_team_invitation" {
+			continue
+		}
+
+		team, email, err := parseCompositeID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		invitations, err := client.TeamInvitationList(context.TODO(), team, &heroku.ListRange{Field: "id", Max: 1000})
+		if err != nil {
+			return err
+		}
+
+		for _, invitation := range invitations {
+			if strings.EqualFold(invitation.User.Email, email) {
+				return fmt.Errorf("Invitation of %s to team %s still exists", email, team)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckHerokuTeamInvitationConfig(team, email, role string) string {
+	return fmt.Sprintf(`
+resource "heroku_team_invitation" "foobar" {
+	team  = "%s"
+	email = "%s"
+	role  = "%s"
+}
+`, team, email, role)
+}
@@ -2,21 +2,21 @@ package heroku
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	heroku "github.com/heroku/heroku-go/v6"
 )
 
 func resourceHerokuTeamMember() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuTeamMemberSet,
-		Read:   resourceHerokuTeamMemberRead,
-		Update: resourceHerokuTeamMemberSet,
-		Delete: resourceHerokuTeamMemberDelete,
+		CreateContext: resourceHerokuTeamMemberSet,
+		ReadContext:   resourceHerokuTeamMemberRead,
+		UpdateContext: resourceHerokuTeamMemberSet,
+		DeleteContext: resourceHerokuTeamMemberDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: resourceHerokuTeamMemberImport,
+			StateContext: resourceHerokuTeamMemberImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,7 +47,7 @@ func resourceHerokuTeamMember() *schema.Resource {
 }
 
 // Callback for schema.ResourceImporter
-func resourceHerokuTeamMemberImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+func resourceHerokuTeamMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	team, email, err := parseCompositeID(d.Id())
 	if err != nil {
 		return nil, err
@@ -55,7 +55,7 @@ func resourceHerokuTeamMemberImport(d *schema.ResourceData, meta interface{}) ([
 	d.Set("team", team)
 	d.Set("email", email)
 
-	readErr := resourceHerokuTeamMemberRead(d, meta)
+	readErr := diagnosticsError(resourceHerokuTeamMemberRead(ctx, d, meta))
 	if readErr != nil {
 		return nil, readErr
 	}
@@ -63,7 +63,7 @@ func resourceHerokuTeamMemberImport(d *schema.ResourceData, meta interface{}) ([
 }
 
 // Callback for schema Resource.Create and schema Resource.Update
-func resourceHerokuTeamMemberSet(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuTeamMemberSet(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	email := d.Get("email").(string)
@@ -77,26 +77,26 @@ func resourceHerokuTeamMemberSet(d *schema.ResourceData, meta interface{}) error
 		Federated: &federated,
 	}
 
-	_, err := client.TeamMemberCreateOrUpdate(context.TODO(), team, opts)
+	_, err := client.TeamMemberCreateOrUpdate(ctx, team, opts)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(buildCompositeID(team, email))
-	return resourceHerokuTeamMemberRead(d, meta)
+	return resourceHerokuTeamMemberRead(ctx, d, meta)
 }
 
 // Callback for schema Resource.Read
-func resourceHerokuTeamMemberRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuTeamMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	team, email, err := parseCompositeID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	members, err := client.TeamMemberList(context.TODO(), team, &heroku.ListRange{Field: "email", Max: 1000})
+	members, err := client.TeamMemberList(ctx, team, &heroku.ListRange{Field: "email", Max: 1000})
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	var found heroku.TeamMember
@@ -108,7 +108,7 @@ func resourceHerokuTeamMemberRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if found.ID == "" {
-		return fmt.Errorf("Could not find member record for %s on team %s", email, team)
+		return diag.Errorf("Could not find member record for %s on team %s", email, team)
 	}
 
 	d.Set("team", team)
@@ -120,16 +120,16 @@ func resourceHerokuTeamMemberRead(d *schema.ResourceData, meta interface{}) erro
 }
 
 // Callback for schema Resource.Delete
-func resourceHerokuTeamMemberDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuTeamMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 	team, email, err := parseCompositeID(d.Id())
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
-	_, err = client.TeamMemberDelete(context.TODO(), team, email)
+	_, err = client.TeamMemberDelete(ctx, team, email)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId("")
@@ -0,0 +1,128 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	heroku "github.com/heroku/heroku-go/v6"
+)
+
+// Every team has preferences, so they are never created or deleted, only
+// updated.
+func resourceHerokuTeamPreferences() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHerokuTeamPreferencesCreate,
+		ReadContext:   resourceHerokuTeamPreferencesRead,
+		UpdateContext: resourceHerokuTeamPreferencesUpdate,
+		DeleteContext: resourceHerokuTeamPreferencesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHerokuTeamPreferencesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"addons_controls": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"default_permission": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceHerokuTeamPreferencesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("team", d.Id())
+
+	readErr := diagnosticsError(resourceHerokuTeamPreferencesRead(ctx, d, meta))
+	if readErr != nil {
+		return nil, readErr
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceHerokuTeamPreferencesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("team").(string))
+
+	if err := updateTeamPreferences(ctx, d, meta); err != nil {
+		d.SetId("")
+		return diag.FromErr(err)
+	}
+
+	return resourceHerokuTeamPreferencesRead(ctx, d, meta)
+}
+
+func resourceHerokuTeamPreferencesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Config).Api
+	team := d.Id()
+
+	preferences, err := client.TeamPreferencesList(ctx, team)
+	if err != nil {
+		return diag.Errorf("Error retrieving preferences of team %s: %s", team, err)
+	}
+
+	d.Set("team", team)
+	if preferences.AddonsControls != nil {
+		d.Set("addons_controls", *preferences.AddonsControls)
+	}
+	if preferences.DefaultPermission != nil {
+		d.Set("default_permission", *preferences.DefaultPermission)
+	}
+
+	return nil
+}
+
+func resourceHerokuTeamPreferencesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := updateTeamPreferences(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceHerokuTeamPreferencesRead(ctx, d, meta)
+}
+
+// A team's preferences cannot be removed, so they are left as they are.
+func resourceHerokuTeamPreferencesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Team preferences cannot be removed, so the preferences of team %s are left as they are.", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func updateTeamPreferences(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*Config).Api
+	team := d.Id()
+
+	// Only preferences which are set in the configuration are updated, so
+	// that unset ones keep their current values.
+	opts := heroku.TeamPreferencesUpdateOpts{}
+	if isSetInRawConfig(d, "addons_controls") && (d.IsNewResource() || d.HasChange("addons_controls")) {
+		addonsControls := d.Get("addons_controls").(bool)
+		opts.AddonsControls = &addonsControls
+	}
+
+	if opts.AddonsControls == nil {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Updating preferences of team %s", team)
+	if _, err := client.TeamPreferencesUpdate(ctx, team, opts); err != nil {
+		return fmt.Errorf("Error updating preferences of team %s: %s", team, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package heroku
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccHerokuTeamPreferences_Basic(t *testing.T) {
+	team := testAccConfig.GetTeamOrSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuTeamPreferencesConfig(team, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_team_preferences.foobar", "addons_controls", "true"),
+					resource.TestCheckResourceAttrSet(
+						"heroku_team_preferences.foobar", "default_permission"),
+				),
+			},
+			{
+				Config: testAccCheckHerokuTeamPreferencesConfig(team, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_team_preferences.foobar", "addons_controls", "false"),
+				),
+			},
+			{
+				ResourceName:      "heroku_team_preferences.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuTeamPreferencesConfig(team string, addonsControls bool) string {
+	return fmt.Sprintf(`
+resource "heroku_team_preferences" "foobar" {
+	team            = "%s"
+	addons_controls = %t
+}
+`, team, addonsControls)
+}
@@ -0,0 +1,76 @@
+package heroku
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccHerokuTeam_EnterpriseAccount(t *testing.T) {
+	teamName := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	teamRename := fmt.Sprintf("tftest-%s", acctest.RandString(10))
+	enterpriseAccountID := testAccConfig.GetEnterpriseAccountIDOrSkip(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckHerokuTeamDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckHerokuTeamConfig(teamName, enterpriseAccountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_team.foobar", "name", teamName),
+					resource.TestCheckResourceAttr(
+						"heroku_team.foobar", "enterprise_account_id", enterpriseAccountID),
+					resource.TestCheckResourceAttr(
+						"heroku_team.foobar", "type", "enterprise"),
+				),
+			},
+			{
+				Config: testAccCheckHerokuTeamConfig(teamRename, enterpriseAccountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"heroku_team.foobar", "name", teamRename),
+				),
+			},
+			{
+				ResourceName:      "heroku_team.foobar",
+				ImportStateId:     teamRename,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckHerokuTeamDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Config).Api
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "heroku_team" {
+			continue
+		}
+
+		if _, err := client.TeamInfo(context.TODO(), rs.Primary.ID); err == nil {
+			return fmt.Errorf("Team still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckHerokuTeamConfig(name, enterpriseAccountID string) string {
+	return fmt.Sprintf(`
+resource "heroku_team" "foobar" {
+  name                  = "%s"
+  enterprise_account_id = "%s"
+}
+`, name, enterpriseAccountID)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	heroku "github.com/heroku/heroku-go/v6"
@@ -12,10 +13,10 @@ import (
 
 func resourceHerokuTelemetryDrain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceHerokuTelemetryDrainCreate,
-		Read:   resourceHerokuTelemetryDrainRead,
-		Update: resourceHerokuTelemetryDrainUpdate,
-		Delete: resourceHerokuTelemetryDrainDelete,
+		CreateContext: resourceHerokuTelemetryDrainCreate,
+		ReadContext:   resourceHerokuTelemetryDrainRead,
+		UpdateContext: resourceHerokuTelemetryDrainUpdate,
+		DeleteContext: resourceHerokuTelemetryDrainDelete,
 
 		Schema: map[string]*schema.Schema{
 			"owner_id": {
@@ -83,15 +84,15 @@ func resourceHerokuTelemetryDrain() *schema.Resource {
 	}
 }
 
-func resourceHerokuTelemetryDrainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuTelemetryDrainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	// Validate that the owner supports OpenTelemetry drains (Fir generation only)
 	ownerID := d.Get("owner_id").(string)
 	ownerType := d.Get("owner_type").(string)
 
-	if err := validateOwnerSupportsOtel(client, ownerID, ownerType); err != nil {
-		return err
+	if err := validateOwnerSupportsOtel(ctx, meta.(*Config), ownerID, ownerType); err != nil {
+		return diag.FromErr(err)
 	}
 
 	// Build create options
@@ -121,25 +122,25 @@ func resourceHerokuTelemetryDrainCreate(d *schema.ResourceData, meta interface{}
 	// Convert signals
 	opts.Signals = convertSignals(d.Get("signals").(*schema.Set))
 
-	log.Printf("[DEBUG] Creating telemetry drain: %#v", opts)
+	log.Printf("[DEBUG] Creating telemetry drain to %s", opts.Exporter.Endpoint)
 
-	drain, err := client.TelemetryDrainCreate(context.TODO(), opts)
+	drain, err := client.TelemetryDrainCreate(ctx, opts)
 	if err != nil {
-		return fmt.Errorf("error creating telemetry drain: %s", err)
+		return diag.Errorf("error creating telemetry drain: %s", err)
 	}
 
 	d.SetId(drain.ID)
 	log.Printf("[INFO] Created telemetry drain ID: %s", drain.ID)
 
-	return resourceHerokuTelemetryDrainRead(d, meta)
+	return resourceHerokuTelemetryDrainRead(ctx, d, meta)
 }
 
-func resourceHerokuTelemetryDrainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuTelemetryDrainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
-	drain, err := client.TelemetryDrainInfo(context.TODO(), d.Id())
+	drain, err := client.TelemetryDrainInfo(ctx, d.Id())
 	if err != nil {
-		return fmt.Errorf("error retrieving telemetry drain: %s", err)
+		return diag.Errorf("error retrieving telemetry drain: %s", err)
 	}
 
 	// Set computed fields
@@ -157,7 +158,7 @@ func resourceHerokuTelemetryDrainRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceHerokuTelemetryDrainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuTelemetryDrainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	opts := heroku.TelemetryDrainUpdateOpts{}
@@ -186,24 +187,24 @@ func resourceHerokuTelemetryDrainUpdate(d *schema.ResourceData, meta interface{}
 		opts.Signals = convertSignalsForUpdate(d.Get("signals").(*schema.Set))
 	}
 
-	log.Printf("[DEBUG] Updating telemetry drain: %#v", opts)
+	log.Printf("[DEBUG] Updating telemetry drain: %s", d.Id())
 
-	_, err := client.TelemetryDrainUpdate(context.TODO(), d.Id(), opts)
+	_, err := client.TelemetryDrainUpdate(ctx, d.Id(), opts)
 	if err != nil {
-		return fmt.Errorf("error updating telemetry drain: %s", err)
+		return diag.Errorf("error updating telemetry drain: %s", err)
 	}
 
-	return resourceHerokuTelemetryDrainRead(d, meta)
+	return resourceHerokuTelemetryDrainRead(ctx, d, meta)
 }
 
-func resourceHerokuTelemetryDrainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceHerokuTelemetryDrainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Config).Api
 
 	log.Printf("[INFO] Deleting telemetry drain: %s", d.Id())
 
-	_, err := client.TelemetryDrainDelete(context.TODO(), d.Id())
+	_, err := client.TelemetryDrainDelete(ctx, d.Id())
 	if err != nil {
-		return fmt.Errorf("error deleting telemetry drain: %s", err)
+		return diag.Errorf("error deleting telemetry drain: %s", err)
 	}
 
 	d.SetId("")
@@ -211,25 +212,27 @@ func resourceHerokuTelemetryDrainDelete(d *schema.ResourceData, meta interface{}
 }
 
 // validateOwnerSupportsOtel checks if the owner (app or space) supports OpenTelemetry drains
-func validateOwnerSupportsOtel(client *heroku.Service, ownerID, ownerType string) error {
+func validateOwnerSupportsOtel(ctx context.Context, config *Config, ownerID, ownerType string) error {
+	client := config.Api
+
 	switch ownerType {
 	case "app":
-		app, err := client.AppInfo(context.TODO(), ownerID)
+		app, err := client.AppInfo(ctx, ownerID)
 		if err != nil {
 			return fmt.Errorf("error fetching app info: %s", err)
 		}
 
-		if !IsFeatureSupported(app.Generation.Name, "app", "otel") {
+		if !config.IsFeatureSupported(ctx, app.Generation.Name, "app", "otel") {
 			return fmt.Errorf("telemetry drains are only supported for Fir generation apps. App '%s' is %s generation. Use heroku_drain for Cedar apps", app.Name, app.Generation.Name)
 		}
 
 	case "space":
-		space, err := client.SpaceInfo(context.TODO(), ownerID)
+		space, err := client.SpaceInfo(ctx, ownerID)
 		if err != nil {
 			return fmt.Errorf("error fetching space info: %s", err)
 		}
 
-		if !IsFeatureSupported(space.Generation.Name, "space", "otel") {
+		if !config.IsFeatureSupported(ctx, space.Generation.Name, "space", "otel") {
 			return fmt.Errorf("telemetry drains are only supported for Fir generation spaces. Space '%s' is %s generation", space.Name, space.Generation.Name)
 		}
 
@@ -0,0 +1,171 @@
+package heroku
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff"
+)
+
+// retryRandomizationFactor jitters each retry interval by +/- 25%, matching
+// heroku-go's RoundTripWithRetryBackoff.
+const retryRandomizationFactor = 0.25
+
+// retryTransport is a net/http RoundTripper that retries transient Heroku API
+// failures with exponential backoff.
+//
+// Rate limited requests (429) are rejected before Heroku processes them, so
+// they are retried regardless of method. Other retryable status codes and
+// network errors are only retried for idempotent methods, as the original
+// request may already have been applied.
+type retryTransport struct {
+	// Base is the transport used to send each attempt.
+	// It will default to http.DefaultTransport if nil.
+	Base http.RoundTripper
+
+	InitialInterval time.Duration
+	Multiplier      float64
+	MaxInterval     time.Duration
+	// After MaxElapsedTime retries stop. It never stops if MaxElapsedTime == 0.
+	MaxElapsedTime time.Duration
+
+	RetryableStatusCodes []int
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	b := &backoff.ExponentialBackOff{
+		Clock:               backoff.SystemClock,
+		InitialInterval:     t.InitialInterval,
+		RandomizationFactor: retryRandomizationFactor,
+		Multiplier:          t.Multiplier,
+		MaxInterval:         t.MaxInterval,
+		MaxElapsedTime:      t.MaxElapsedTime,
+	}
+	b.Reset()
+
+	getBody, err := rewindableBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 1; ; attempt++ {
+		// Each attempt is sent as a clone with a fresh body, as a RoundTripper
+		// must not modify the caller's request.
+		attemptReq := req.Clone(req.Context())
+		if getBody != nil {
+			body, err := getBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq.Body = body
+		}
+
+		resp, err := base.RoundTrip(attemptReq)
+
+		reason := t.retryReason(req, resp, err)
+		if reason == "" {
+			return resp, err
+		}
+
+		wait := b.NextBackOff()
+		if wait == backoff.Stop {
+			log.Printf("[WARN] Giving up on Heroku API request %s %s after %d attempts: %s",
+				req.Method, req.URL.Path, attempt, reason)
+			return resp, err
+		}
+
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		log.Printf("[WARN] Will retry Heroku API request %s %s in %s (attempt %d), because %s",
+			req.Method, req.URL.Path, wait, attempt, reason)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rewindableBody returns a function returning a fresh copy of the request's
+// body for each attempt, or nil when the request has no body. The request's own
+// body is closed, buffering it first when the request cannot provide copies.
+func rewindableBody(req *http.Request) (func() (io.ReadCloser, error), error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	defer req.Body.Close()
+
+	if req.GetBody != nil {
+		return req.GetBody, nil
+	}
+
+	buf, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	return func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(buf)), nil
+	}, nil
+}
+
+// retryReason returns why the given attempt should be retried, or an empty
+// string when the response or error should be returned to the caller as-is.
+func (t *retryTransport) retryReason(req *http.Request, resp *http.Response, err error) string {
+	if req.Context().Err() != nil {
+		return ""
+	}
+
+	if err != nil {
+		if isIdempotentMethod(req.Method) {
+			return fmt.Sprintf("request failed: %s", err)
+		}
+		return ""
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		if isRetryableStatusCode(t.RetryableStatusCodes, resp.StatusCode) {
+			return "Heroku API rate limited: 429 Too Many Requests"
+		}
+		return ""
+	}
+
+	if isRetryableStatusCode(t.RetryableStatusCodes, resp.StatusCode) && isIdempotentMethod(req.Method) {
+		return fmt.Sprintf("Heroku API responded %s", resp.Status)
+	}
+
+	return ""
+}
+
+func isRetryableStatusCode(codes []int, code int) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// isIdempotentMethod reports whether a request with the given method is safe
+// to repeat, per RFC 7231 section 4.2.2.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
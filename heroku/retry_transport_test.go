@@ -0,0 +1,165 @@
+package heroku
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRetryTransport() *retryTransport {
+	return &retryTransport{
+		InitialInterval:      time.Millisecond,
+		Multiplier:           DefaultRetryMultiplier,
+		MaxInterval:          10 * time.Millisecond,
+		MaxElapsedTime:       time.Second,
+		RetryableStatusCodes: DefaultRetryableStatusCodes,
+	}
+}
+
+func TestRetryTransport_RetriesIdempotentRequests(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"name":"some-app"}` {
+			t.Errorf("got body %q on attempt %d", body, atomic.LoadInt32(&calls)+1)
+		}
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: testRetryTransport()}
+	req, err := http.NewRequest(http.MethodPut, srv.URL, strings.NewReader(`{"name":"some-app"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("got status %d, want 200", resp.StatusCode)
+	}
+	if calls != 3 {
+		t.Fatalf("got %d calls, want 3", calls)
+	}
+}
+
+func TestRetryTransport_DoesNotRetryNonIdempotentRequests(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: testRetryTransport()}
+	resp, err := client.Post(srv.URL, "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway {
+		t.Fatalf("got status %d, want 502", resp.StatusCode)
+	}
+	if calls != 1 {
+		t.Fatalf("got %d calls, want 1", calls)
+	}
+}
+
+func TestRetryTransport_RetriesRateLimitedRequests(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: testRetryTransport()}
+	resp, err := client.Post(srv.URL, "application/json", strings.NewReader(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("got status %d, want 201", resp.StatusCode)
+	}
+	if calls != 2 {
+		t.Fatalf("got %d calls, want 2", calls)
+	}
+}
+
+func TestRetryTransport_GivesUpAfterMaxElapsedTime(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGatewayTimeout)
+	}))
+	defer srv.Close()
+
+	transport := testRetryTransport()
+	transport.MaxElapsedTime = 20 * time.Millisecond
+
+	client := &http.Client{Transport: transport}
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusGatewayTimeout {
+		t.Fatalf("got status %d, want 504", resp.StatusCode)
+	}
+}
+
+func TestRetryTransport_LeavesCallerRequestUnmodified(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"name":"some-app"}` {
+			t.Errorf("got body %q on attempt %d", body, atomic.LoadInt32(&calls)+1)
+		}
+		if atomic.AddInt32(&calls, 1) < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	// A body without GetBody is buffered so that it can be resent.
+	body := io.NopCloser(strings.NewReader(`{"name":"some-app"}`))
+	req, err := http.NewRequest(http.MethodPut, srv.URL, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req.GetBody != nil {
+		t.Fatal("expected a request without GetBody")
+	}
+
+	resp, err := testRetryTransport().RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if calls != 2 {
+		t.Fatalf("got %d calls, want 2", calls)
+	}
+	if req.Body != body {
+		t.Fatal("the caller's request body was replaced")
+	}
+}
@@ -0,0 +1,106 @@
+package heroku
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// newNetworkTransport returns the transport which sends every request the
+// provider makes, to the Heroku API and to source and slug blob storage,
+// configured with the provider's proxy and TLS settings.
+func (c *Config) newNetworkTransport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	// Without a proxy_url, the standard HTTPS_PROXY, HTTP_PROXY and NO_PROXY
+	// environment variables are honored, as by http.DefaultTransport.
+	if c.ProxyURL != "" {
+		proxyURL, err := parseProxyURL(c.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if c.CABundleFile != "" {
+		pool, err := loadCABundle(c.CABundleFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.InsecureSkipVerify {
+		log.Printf("[WARN] TLS certificate verification is disabled, which should only be used with local stand-ins for the Heroku API")
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	if c.ClientCertificateFile != "" || c.ClientKeyFile != "" {
+		if c.ClientCertificateFile == "" || c.ClientKeyFile == "" {
+			return nil, fmt.Errorf("provider configuration error: client_certificate_file and client_key_file must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(c.ClientCertificateFile, c.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("provider configuration error: unable to load client certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+func parseProxyURL(v string) (*url.URL, error) {
+	proxyURL, err := url.Parse(v)
+	if err != nil {
+		return nil, fmt.Errorf("provider configuration error: invalid proxy_url: %s", err)
+	}
+
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("provider configuration error: proxy_url must use the http, https or socks5 scheme, got %q", proxyURL.Scheme)
+	}
+	if proxyURL.Host == "" {
+		return nil, fmt.Errorf("provider configuration error: proxy_url must include a host")
+	}
+
+	return proxyURL, nil
+}
+
+// loadCABundle returns the system's certificate pool, extended with the PEM
+// encoded certificates of file, such as a TLS-intercepting proxy's CA.
+func loadCABundle(file string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("provider configuration error: unable to read ca_bundle_file: %s", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("provider configuration error: no PEM encoded certificates found in ca_bundle_file %s", file)
+	}
+
+	return pool, nil
+}
+
+// blobClient returns the HTTP client for source and slug blobs, which are
+// stored outside of the Heroku API, but reached through the same transport.
+func (c *Config) blobClient() *http.Client {
+	if c.transport == nil {
+		return &http.Client{}
+	}
+	return &http.Client{Transport: c.transport}
+}
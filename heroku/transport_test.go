@@ -0,0 +1,211 @@
+package heroku
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeTestPEM(t *testing.T, name, blockType string, der []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// newTestClientCertificate writes a self-signed client certificate and its
+// key, returning their paths and the certificate.
+func newTestClientCertificate(t *testing.T) (string, string, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "terraform-provider-heroku"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return writeTestPEM(t, "client.crt", "CERTIFICATE", der), writeTestPEM(t, "client.key", "EC PRIVATE KEY", keyDER), cert
+}
+
+func TestNetworkTransport_ProxyURL(t *testing.T) {
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+	}))
+	defer proxy.Close()
+
+	config := &Config{ProxyURL: proxy.URL}
+	transport, err := config.newNetworkTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Get("http://api.heroku.invalid/account")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if proxied != "http://api.heroku.invalid/account" {
+		t.Fatalf("request was not sent through the proxy, got %q", proxied)
+	}
+}
+
+func TestNetworkTransport_TLS(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	caBundle := writeTestPEM(t, "ca.pem", "CERTIFICATE", srv.Certificate().Raw)
+
+	testCases := []struct {
+		name    string
+		config  Config
+		wantErr string
+	}{
+		{
+			name:    "Untrusted certificate",
+			config:  Config{},
+			wantErr: "certificate",
+		},
+		{
+			name:   "CA bundle",
+			config: Config{CABundleFile: caBundle},
+		},
+		{
+			name:   "Insecure skip verify",
+			config: Config{InsecureSkipVerify: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			transport, err := tc.config.newNetworkTransport()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				resp.Body.Close()
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("got error %v, want one containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestNetworkTransport_ClientCertificate(t *testing.T) {
+	certFile, keyFile, cert := newTestClientCertificate(t)
+
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(cert)
+
+	var presented string
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		presented = r.TLS.PeerCertificates[0].Subject.CommonName
+	}))
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCAs}
+	srv.StartTLS()
+	defer srv.Close()
+
+	config := &Config{
+		CABundleFile:          writeTestPEM(t, "ca.pem", "CERTIFICATE", srv.Certificate().Raw),
+		ClientCertificateFile: certFile,
+		ClientKeyFile:         keyFile,
+	}
+	transport, err := config.newNetworkTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if presented != "terraform-provider-heroku" {
+		t.Fatalf("client certificate was not presented, got %q", presented)
+	}
+}
+
+func TestNetworkTransport_InvalidConfig(t *testing.T) {
+	certFile, _, _ := newTestClientCertificate(t)
+	notPEM := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name    string
+		config  Config
+		wantErr string
+	}{
+		{
+			name:    "Proxy URL scheme",
+			config:  Config{ProxyURL: "ftp://proxy.example.com"},
+			wantErr: "proxy_url must use the http, https or socks5 scheme",
+		},
+		{
+			name:    "Proxy URL host",
+			config:  Config{ProxyURL: "http://"},
+			wantErr: "proxy_url must include a host",
+		},
+		{
+			name:    "Missing CA bundle",
+			config:  Config{CABundleFile: filepath.Join(t.TempDir(), "missing.pem")},
+			wantErr: "unable to read ca_bundle_file",
+		},
+		{
+			name:    "CA bundle without certificates",
+			config:  Config{CABundleFile: notPEM},
+			wantErr: "no PEM encoded certificates found",
+		},
+		{
+			name:    "Client certificate without key",
+			config:  Config{ClientCertificateFile: certFile},
+			wantErr: "client_certificate_file and client_key_file must be set together",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.config.newNetworkTransport()
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("got error %v, want one containing %q", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package heroku
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/google/uuid"
 )
@@ -46,3 +49,27 @@ func validateOCIImage(val interface{}, key string) ([]string, []error) {
 
 	return nil, []error{fmt.Errorf("%q is an invalid OCI image identifier: must be a UUID or SHA256 digest (sha256:hex or bare hex)", key)}
 }
+
+// validatePEMCertificate validates that a string is a PEM-encoded X.509 certificate
+func validatePEMCertificate(val interface{}, key string) ([]string, []error) {
+	s, ok := val.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("%q is an invalid certificate: unable to assert %q to string", key, val)}
+	}
+
+	block, rest := pem.Decode([]byte(strings.TrimSpace(s)))
+	if block == nil {
+		return nil, []error{fmt.Errorf("%q is an invalid certificate: no PEM data found", key)}
+	}
+	if block.Type != "CERTIFICATE" {
+		return nil, []error{fmt.Errorf("%q is an invalid certificate: expected a CERTIFICATE PEM block, got %s", key, block.Type)}
+	}
+	if len(rest) != 0 {
+		return nil, []error{fmt.Errorf("%q is an invalid certificate: unexpected data after the certificate", key)}
+	}
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+		return nil, []error{fmt.Errorf("%q is an invalid certificate: %s", key, err)}
+	}
+
+	return nil, nil
+}
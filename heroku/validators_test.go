@@ -1,6 +1,10 @@
 package heroku
 
-import "testing"
+import (
+	"encoding/pem"
+	"os"
+	"testing"
+)
 
 func TestValidateUUID(t *testing.T) {
 	valid := []interface{}{
@@ -140,3 +144,41 @@ func TestValidateArtifactForGeneration(t *testing.T) {
 		}
 	})
 }
+
+func TestValidatePEMCertificate(t *testing.T) {
+	certPath, keyPath, cert := newTestClientCertificate(t)
+	certPEM, err := os.ReadFile(certPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid := []interface{}{
+		string(certPEM),
+		"\n" + string(certPEM) + "\n\n",
+	}
+	for _, v := range valid {
+		_, errors := validatePEMCertificate(v, "certificate")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid certificate: %q", v, errors)
+		}
+	}
+
+	invalid := []interface{}{
+		"",
+		"not a certificate",
+		string(keyPEM),
+		string(certPEM) + string(certPEM),
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw[:10]})),
+		1,
+	}
+	for _, v := range invalid {
+		_, errors := validatePEMCertificate(v, "certificate")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid certificate", v)
+		}
+	}
+}